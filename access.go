@@ -3,37 +3,60 @@ package buckets
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	ipld "github.com/ipfs/go-ipld-format"
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/dcrypto"
+	"github.com/textileio/go-buckets/audit"
 	"github.com/textileio/go-buckets/collection"
 	"github.com/textileio/go-buckets/dag"
 	"github.com/textileio/go-threads/core/did"
 	core "github.com/textileio/go-threads/core/thread"
 )
 
+// PushPathAccessRoles updates access roles at a path.
+// Roles can be granted to an identity, to all identities with "*",
+// or to the members of a thread group with collection.GroupPrincipal.
+// Groups are referenced in the bucket by name and owner, and their members are resolved when the bucket
+// is accessed. Only the bucket owner can grant roles to a group that isn't already referenced.
 func (b *Buckets) PushPathAccessRoles(
 	ctx context.Context,
 	thread core.ID,
 	key, pth string,
 	roles map[did.DID]collection.Role,
+	root path.Resolved,
 	identity did.Token,
 ) (int64, *Bucket, error) {
+	caller, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+
 	lk := b.locks.Get(lock(key))
 	lk.Acquire()
 	defer lk.Release()
 
-	pth, err := parsePath(pth)
+	pth, err = parsePath(pth)
 	if err != nil {
 		return 0, nil, err
 	}
 
-	instance, bpth, err := b.getBucketAndPath(ctx, thread, key, pth, identity)
+	ctx, instance, err := b.getSafe(ctx, thread, key, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+	bpth, err := getBucketPath(instance, pth)
 	if err != nil {
 		return 0, nil, err
 	}
+	ctx = dag.NewOwnerContext(ctx, instance.Owner)
+	if root != nil && root.String() != instance.Path {
+		return 0, nil, ErrNonFastForward
+	}
+	oldRoot := instance.Path
 	linkKey := instance.GetLinkEncryptionKey()
 	pathNode, err := dag.GetNodeAtPath(ctx, b.ipfs, bpth, linkKey)
 	if err != nil {
@@ -81,12 +104,15 @@ func (b *Buckets) PushPathAccessRoles(
 		instance.UpdatedAt = time.Now().UnixNano()
 		target.UpdatedAt = instance.UpdatedAt
 		instance.Metadata[pth] = target
+		if err := b.resolveGroups(ctx, thread, instance, roles, identity); err != nil {
+			return 0, nil, err
+		}
 		if instance.IsPrivate() {
 			if err := instance.RotateFileEncryptionKeysForPrefix(pth); err != nil {
 				return 0, nil, err
 			}
 		}
-		if err := b.c.Verify(ctx, thread, instance, collection.WithIdentity(identity)); err != nil {
+		if err := b.verify(ctx, thread, instance, identity); err != nil {
 			return 0, nil, err
 		}
 
@@ -117,24 +143,39 @@ func (b *Buckets) PushPathAccessRoles(
 			}
 			pn := nmap[pathNode.Cid()].Node
 			var dirPath path.Resolved
-			ctx, dirPath, err = dag.InsertNodeAtPath(ctx, b.ipfs, pn, path.Join(path.New(instance.Path), pth), linkKey)
+			ctx, dirPath, err = dag.InsertNodeAtPath(ctx, b.ipfs, b.pinner, pn, path.Join(path.New(instance.Path), pth), linkKey)
 			if err != nil {
 				return 0, nil, err
 			}
-			ctx, err = dag.AddAndPinNodes(ctx, b.ipfs, nodes)
+			ctx, err = dag.AddAndPinNodes(ctx, b.ipfs, b.pinner, nodes)
 			if err != nil {
 				return 0, nil, err
 			}
 			instance.Path = dirPath.String()
+
+			// Versions encrypted with the rotated keys can no longer be restored
+			ctx, err = b.retireVersions(ctx, instance, pth)
+			if err != nil {
+				return 0, nil, err
+			}
 		}
 
-		if err := b.c.Save(ctx, thread, instance, collection.WithIdentity(identity)); err != nil {
+		// A retired replaced root must not be pinned again
+		n := len(instance.History)
+		retired := n > 0 && !instance.History[n-1].Pinned
+		ctx, err = b.addVersion(ctx, instance, caller, retired)
+		if err != nil {
+			return 0, nil, err
+		}
+		b.changes.add(thread, instance, caller, oldRoot, change{typ: EventRolesChanged, path: pth})
+		if err := b.save(ctx, thread, instance, identity); err != nil {
 			return 0, nil, err
 		}
+		b.record(ctx, thread, key, caller, audit.OpPushPathAccessRoles, []string{pth}, oldRoot, instance.Path)
 	}
 
 	log.Debugf("pushed access roles for %s in %s", pth, key)
-	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, instance), nil
+	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, view(ctx, thread, instance)), nil
 }
 
 func (b *Buckets) PullPathAccessRoles(
@@ -162,3 +203,167 @@ func (b *Buckets) PullPathAccessRoles(
 	log.Debugf("pulled access roles for %s in %s", pth, key)
 	return md.Roles, nil
 }
+
+// AccessGrant describes an access role granted at a bucket path.
+type AccessGrant struct {
+	// Path is the metadata path at which the role is granted.
+	Path string `json:"path"`
+	// Principal is the identity, "*", or group principal the role is granted to.
+	Principal did.DID `json:"principal"`
+	// Role is the granted role.
+	Role collection.Role `json:"role"`
+}
+
+// AccessEntry describes the access roles of a single metadata entry.
+type AccessEntry struct {
+	Path  string                      `json:"path"`
+	Roles map[did.DID]collection.Role `json:"roles"`
+}
+
+// AccessReport explains the effective access role of an identity at a bucket path.
+type AccessReport struct {
+	// Identity is the identity that was checked.
+	Identity did.DID `json:"identity"`
+	// Path is the checked path.
+	Path string `json:"path"`
+	// Role is the effective role, which is the most privileged role granted at path or any of its ancestors.
+	Role collection.Role `json:"role"`
+	// From is the path at which the effective role is granted.
+	From string `json:"from"`
+	// Principal is the principal the effective role is granted to.
+	Principal did.DID `json:"principal"`
+	// Owner indicates whether or not the identity is the bucket owner.
+	Owner bool `json:"owner"`
+	// Grants lists every role that applies to the identity at path, ordered from the root.
+	Grants []AccessGrant `json:"grants"`
+	// Tree lists the access roles of every metadata entry readable by the caller, ordered by path.
+	Tree []AccessEntry `json:"tree"`
+}
+
+// EffectiveAccess returns an AccessReport explaining the access role of id at a path.
+// Roles are resolved as they are when the bucket is accessed:
+// Roles granted to id directly, to "*", or to a group id is currently a member of apply to the path and all its descendants,
+// and the most privileged role wins. A role granted to "*" never confers more than write access.
+func (b *Buckets) EffectiveAccess(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	id did.DID,
+	identity did.Token,
+) (*AccessReport, error) {
+	pth, err := parsePath(pth)
+	if err != nil {
+		return nil, err
+	}
+	pth = strings.TrimSuffix(pth, "/")
+	instance, bpth, err := b.getBucketAndPath(ctx, thread, key, pth, identity)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dag.GetNodeAtPath(ctx, b.ipfs, bpth, instance.GetLinkEncryptionKey()); err != nil {
+		return nil, fmt.Errorf("could not resolve path: %s", pth)
+	}
+	if _, _, ok := instance.GetMetadataForPath(pth, false); !ok {
+		return nil, fmt.Errorf("could not resolve path: %s", pth)
+	}
+
+	groups, err := b.groupsOf(ctx, thread, id, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &AccessReport{
+		Identity: id,
+		Path:     pth,
+		Owner:    id == instance.Owner,
+		Grants:   []AccessGrant{},
+		Tree:     []AccessEntry{},
+	}
+	for _, p := range pathAncestors(pth) {
+		md, ok := instance.Metadata[p]
+		if !ok {
+			continue
+		}
+		for _, g := range sortedGrants(p, md.Roles) {
+			role, ok := grantedRole(instance, g.Principal, g.Role, id, groups)
+			if !ok {
+				continue
+			}
+			g.Role = role
+			report.Grants = append(report.Grants, g)
+			if role >= report.Role && role > collection.NoneRole {
+				report.Role = role
+				report.From = p
+				report.Principal = g.Principal
+			}
+		}
+	}
+	for p, md := range instance.Metadata {
+		report.Tree = append(report.Tree, AccessEntry{
+			Path:  p,
+			Roles: md.Roles,
+		})
+	}
+	sort.Slice(report.Tree, func(i, j int) bool {
+		return report.Tree[i].Path < report.Tree[j].Path
+	})
+
+	log.Debugf("got effective access for %s at %s in %s", id, pth, key)
+	return report, nil
+}
+
+// grantedRole returns the role conferred to id by a role granted to principal.
+// groups are the groups id is a member of, keyed by name, with their owners.
+// ok is false if the grant does not apply to id.
+func grantedRole(
+	instance *collection.Bucket,
+	principal did.DID,
+	role collection.Role,
+	id did.DID,
+	groups map[string]did.DID,
+) (collection.Role, bool) {
+	if principal == id {
+		return role, true
+	}
+	if principal == "*" {
+		if role > collection.WriterRole {
+			role = collection.WriterRole
+		}
+		return role, true
+	}
+	if name, ok := collection.GroupFromPrincipal(principal); ok {
+		if owner, ok := groups[name]; ok && instance.GroupOwners[name] == owner {
+			return role, true
+		}
+	}
+	return collection.NoneRole, false
+}
+
+// sortedGrants returns the roles at path as grants ordered by principal.
+func sortedGrants(pth string, roles map[did.DID]collection.Role) []AccessGrant {
+	grants := make([]AccessGrant, 0, len(roles))
+	for p, r := range roles {
+		grants = append(grants, AccessGrant{
+			Path:      pth,
+			Principal: p,
+			Role:      r,
+		})
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		return grants[i].Principal < grants[j].Principal
+	})
+	return grants
+}
+
+// pathAncestors returns the bucket root, each ancestor of pth, and pth, ordered from the root.
+func pathAncestors(pth string) []string {
+	ancestors := []string{""}
+	if pth == "" {
+		return ancestors
+	}
+	parts := strings.Split(pth, "/")
+	for i := range parts {
+		ancestors = append(ancestors, strings.Join(parts[:i+1], "/"))
+	}
+	return ancestors
+}
@@ -18,7 +18,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/textileio/go-buckets"
 	"github.com/textileio/go-buckets/api/common"
+	"github.com/textileio/go-buckets/audit"
 	"github.com/textileio/go-buckets/ipns"
+	"github.com/textileio/go-buckets/pinning"
+	"github.com/textileio/go-buckets/quota"
+	"github.com/textileio/go-buckets/share"
 	"github.com/textileio/go-buckets/util"
 	dbc "github.com/textileio/go-threads/api/client"
 	"github.com/textileio/go-threads/core/did"
@@ -27,12 +31,15 @@ import (
 	tutil "github.com/textileio/go-threads/util"
 )
 
-func NewService(t *testing.T) (listenAddr string, host did.DID) {
+func NewService(t *testing.T, opts ...buckets.BucketsOption) (listenAddr string, host did.DID) {
 	err := tutil.SetLogLevels(map[string]logging.LogLevel{
-		"buckets":      logging.LevelDebug,
-		"buckets-api":  logging.LevelDebug,
-		"buckets-ipns": logging.LevelDebug,
-		"buckets-dns":  logging.LevelDebug,
+		"buckets":       logging.LevelDebug,
+		"buckets-api":   logging.LevelDebug,
+		"buckets-ipns":  logging.LevelDebug,
+		"buckets-dns":   logging.LevelDebug,
+		"buckets-quota": logging.LevelDebug,
+		"buckets-share": logging.LevelDebug,
+		"buckets-audit": logging.LevelDebug,
 	})
 	require.NoError(t, err)
 
@@ -50,13 +57,29 @@ func NewService(t *testing.T) (listenAddr string, host did.DID) {
 	require.NoError(t, err)
 	ipnsm, err := ipns.NewManager(tdb.NewTxMapDatastore(), ipfs)
 	require.NoError(t, err)
-	lib, err := buckets.NewBuckets(net, db, ipfs, ipnsm, nil)
+	lib, err := buckets.NewBuckets(
+		net,
+		db,
+		ipfs,
+		pinning.NewMemoryPinner(),
+		ipnsm,
+		nil,
+		audit.NewLog(tdb.NewTxMapDatastore()),
+		opts...,
+	)
 	require.NoError(t, err)
 
 	listenPort, err := freeport.GetFreePort()
 	require.NoError(t, err)
 	listenAddr = fmt.Sprintf("127.0.0.1:%d", listenPort)
-	server, proxy, err := common.GetServerAndProxy(lib, listenAddr, "127.0.0.1:0")
+	ledger := quota.NewLedger(tdb.NewTxMapDatastore(), quota.Config{})
+	server, proxy, err := common.GetServerAndProxy(
+		lib,
+		listenAddr,
+		"127.0.0.1:0",
+		common.WithQuota(ledger),
+		common.WithShares(share.NewStore(tdb.NewTxMapDatastore())),
+	)
 	require.NoError(t, err)
 
 	t.Cleanup(func() {
@@ -1,9 +1,18 @@
 package cast
 
 import (
+	"fmt"
+	"time"
+
+	c "github.com/ipfs/go-cid"
+	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/go-buckets"
 	pb "github.com/textileio/go-buckets/api/pb/buckets"
+	"github.com/textileio/go-buckets/audit"
 	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/quota"
+	"github.com/textileio/go-buckets/share"
+	"github.com/textileio/go-buckets/util"
 	"github.com/textileio/go-threads/core/did"
 	"github.com/textileio/go-threads/core/thread"
 )
@@ -58,17 +67,19 @@ func BucketFromPb(bucket *pb.Bucket) (buckets.Bucket, error) {
 
 func MetadataToPb(md collection.Metadata) *pb.Metadata {
 	return &pb.Metadata{
-		Key:       md.Key,
-		Roles:     RolesToPb(md.Roles),
-		UpdatedAt: md.UpdatedAt,
+		Key:        md.Key,
+		Roles:      RolesToPb(md.Roles),
+		Attributes: md.Attributes,
+		UpdatedAt:  md.UpdatedAt,
 	}
 }
 
 func MetadataFromPb(md *pb.Metadata) collection.Metadata {
 	return collection.Metadata{
-		Key:       md.Key,
-		Roles:     RolesFromPb(md.Roles),
-		UpdatedAt: md.UpdatedAt,
+		Key:        md.Key,
+		Roles:      RolesFromPb(md.Roles),
+		Attributes: md.Attributes,
+		UpdatedAt:  md.UpdatedAt,
 	}
 }
 
@@ -92,18 +103,7 @@ func ItemToPb(item *buckets.PathItem) *pb.PathItem {
 func RolesToPb(roles map[did.DID]collection.Role) map[string]pb.PathAccessRole {
 	proles := make(map[string]pb.PathAccessRole)
 	for k, r := range roles {
-		var pr pb.PathAccessRole
-		switch r {
-		case collection.ReaderRole:
-			pr = pb.PathAccessRole_PATH_ACCESS_ROLE_READER
-		case collection.WriterRole:
-			pr = pb.PathAccessRole_PATH_ACCESS_ROLE_WRITER
-		case collection.AdminRole:
-			pr = pb.PathAccessRole_PATH_ACCESS_ROLE_ADMIN
-		default:
-			pr = pb.PathAccessRole_PATH_ACCESS_ROLE_UNSPECIFIED
-		}
-		proles[string(k)] = pr
+		proles[string(k)] = RoleToPb(r)
 	}
 	return proles
 }
@@ -111,22 +111,93 @@ func RolesToPb(roles map[did.DID]collection.Role) map[string]pb.PathAccessRole {
 func RolesFromPb(roles map[string]pb.PathAccessRole) map[did.DID]collection.Role {
 	croles := make(map[did.DID]collection.Role)
 	for k, pr := range roles {
-		var r collection.Role
-		switch pr {
-		case pb.PathAccessRole_PATH_ACCESS_ROLE_READER:
-			r = collection.ReaderRole
-		case pb.PathAccessRole_PATH_ACCESS_ROLE_WRITER:
-			r = collection.WriterRole
-		case pb.PathAccessRole_PATH_ACCESS_ROLE_ADMIN:
-			r = collection.AdminRole
-		default:
-			r = collection.NoneRole
-		}
-		croles[did.DID(k)] = r
+		croles[did.DID(k)] = RoleFromPb(pr)
 	}
 	return croles
 }
 
+func RoleToPb(role collection.Role) pb.PathAccessRole {
+	switch role {
+	case collection.ReaderRole:
+		return pb.PathAccessRole_PATH_ACCESS_ROLE_READER
+	case collection.WriterRole:
+		return pb.PathAccessRole_PATH_ACCESS_ROLE_WRITER
+	case collection.AdminRole:
+		return pb.PathAccessRole_PATH_ACCESS_ROLE_ADMIN
+	default:
+		return pb.PathAccessRole_PATH_ACCESS_ROLE_UNSPECIFIED
+	}
+}
+
+func RoleFromPb(role pb.PathAccessRole) collection.Role {
+	switch role {
+	case pb.PathAccessRole_PATH_ACCESS_ROLE_READER:
+		return collection.ReaderRole
+	case pb.PathAccessRole_PATH_ACCESS_ROLE_WRITER:
+		return collection.WriterRole
+	case pb.PathAccessRole_PATH_ACCESS_ROLE_ADMIN:
+		return collection.AdminRole
+	default:
+		return collection.NoneRole
+	}
+}
+
+func AccessReportToPb(report *buckets.AccessReport) *pb.EffectiveAccessResponse {
+	grants := make([]*pb.EffectiveAccessResponse_Grant, len(report.Grants))
+	for i, g := range report.Grants {
+		grants[i] = &pb.EffectiveAccessResponse_Grant{
+			Path:      g.Path,
+			Principal: string(g.Principal),
+			Role:      RoleToPb(g.Role),
+		}
+	}
+	tree := make([]*pb.EffectiveAccessResponse_Entry, len(report.Tree))
+	for i, e := range report.Tree {
+		tree[i] = &pb.EffectiveAccessResponse_Entry{
+			Path:  e.Path,
+			Roles: RolesToPb(e.Roles),
+		}
+	}
+	return &pb.EffectiveAccessResponse{
+		Identity:  string(report.Identity),
+		Path:      report.Path,
+		Role:      RoleToPb(report.Role),
+		From:      report.From,
+		Principal: string(report.Principal),
+		Owner:     report.Owner,
+		Grants:    grants,
+		Tree:      tree,
+	}
+}
+
+func AccessReportFromPb(res *pb.EffectiveAccessResponse) *buckets.AccessReport {
+	grants := make([]buckets.AccessGrant, len(res.Grants))
+	for i, g := range res.Grants {
+		grants[i] = buckets.AccessGrant{
+			Path:      g.Path,
+			Principal: did.DID(g.Principal),
+			Role:      RoleFromPb(g.Role),
+		}
+	}
+	tree := make([]buckets.AccessEntry, len(res.Tree))
+	for i, e := range res.Tree {
+		tree[i] = buckets.AccessEntry{
+			Path:  e.Path,
+			Roles: RolesFromPb(e.Roles),
+		}
+	}
+	return &buckets.AccessReport{
+		Identity:  did.DID(res.Identity),
+		Path:      res.Path,
+		Role:      RoleFromPb(res.Role),
+		From:      res.From,
+		Principal: did.DID(res.Principal),
+		Owner:     res.Owner,
+		Grants:    grants,
+		Tree:      tree,
+	}
+}
+
 func LinksToPb(links buckets.Links) *pb.Links {
 	return &pb.Links{
 		Url:  links.URL,
@@ -142,3 +213,575 @@ func LinksFromPb(links *pb.Links) buckets.Links {
 		IPNS: links.Ipns,
 	}
 }
+
+func VersionsToPb(versions []collection.Root) []*pb.Root {
+	pversions := make([]*pb.Root, len(versions))
+	for i, v := range versions {
+		pversions[i] = &pb.Root{
+			Path:      v.Path,
+			Author:    string(v.Author),
+			Pinned:    v.Pinned,
+			CreatedAt: v.CreatedAt,
+		}
+	}
+	return pversions
+}
+
+func VersionsFromPb(versions []*pb.Root) []collection.Root {
+	cversions := make([]collection.Root, len(versions))
+	for i, v := range versions {
+		cversions[i] = collection.Root{
+			Path:      v.Path,
+			Author:    did.DID(v.Author),
+			Pinned:    v.Pinned,
+			CreatedAt: v.CreatedAt,
+		}
+	}
+	return cversions
+}
+
+func BatchOpsToPb(ops []buckets.BatchOp) []*pb.BatchOp {
+	pops := make([]*pb.BatchOp, len(ops))
+	for i, op := range ops {
+		var pt pb.BatchOpType
+		switch op.Type {
+		case buckets.BatchSetPath:
+			pt = pb.BatchOpType_BATCH_OP_TYPE_SET_PATH
+		case buckets.BatchMovePath:
+			pt = pb.BatchOpType_BATCH_OP_TYPE_MOVE_PATH
+		case buckets.BatchRemovePath:
+			pt = pb.BatchOpType_BATCH_OP_TYPE_REMOVE_PATH
+		case buckets.BatchPutPath:
+			pt = pb.BatchOpType_BATCH_OP_TYPE_PUT_PATH
+		default:
+			pt = pb.BatchOpType_BATCH_OP_TYPE_UNSPECIFIED
+		}
+		var id string
+		if op.Cid.Defined() {
+			id = op.Cid.String()
+		}
+		pops[i] = &pb.BatchOp{
+			Type:   pt,
+			Path:   op.Path,
+			ToPath: op.ToPath,
+			Cid:    id,
+			Data:   op.Data,
+		}
+	}
+	return pops
+}
+
+func BatchOpsFromPb(ops []*pb.BatchOp) ([]buckets.BatchOp, error) {
+	bops := make([]buckets.BatchOp, len(ops))
+	for i, op := range ops {
+		var t buckets.BatchOpType
+		switch op.Type {
+		case pb.BatchOpType_BATCH_OP_TYPE_SET_PATH:
+			t = buckets.BatchSetPath
+		case pb.BatchOpType_BATCH_OP_TYPE_MOVE_PATH:
+			t = buckets.BatchMovePath
+		case pb.BatchOpType_BATCH_OP_TYPE_REMOVE_PATH:
+			t = buckets.BatchRemovePath
+		case pb.BatchOpType_BATCH_OP_TYPE_PUT_PATH:
+			t = buckets.BatchPutPath
+		default:
+			return nil, fmt.Errorf("invalid operation type: %s", op.Type)
+		}
+		var id c.Cid
+		if len(op.Cid) != 0 {
+			var err error
+			id, err = c.Decode(op.Cid)
+			if err != nil {
+				return nil, fmt.Errorf("decoding cid: %v", err)
+			}
+		}
+		bops[i] = buckets.BatchOp{
+			Type:   t,
+			Path:   op.Path,
+			ToPath: op.ToPath,
+			Cid:    id,
+			Data:   op.Data,
+		}
+	}
+	return bops, nil
+}
+
+func UploadSessionToPb(session *buckets.UploadSession) *pb.UploadSession {
+	var root string
+	if session.Root != nil {
+		root = session.Root.String()
+	}
+	files := make(map[string]*pb.UploadSession_UploadFile)
+	for p, f := range session.Files {
+		chunks := make([]string, len(f.Chunks))
+		for i, ch := range f.Chunks {
+			chunks[i] = ch.String()
+		}
+		var id string
+		if f.Cid.Defined() {
+			id = f.Cid.String()
+		}
+		files[p] = &pb.UploadSession_UploadFile{
+			Chunks:   chunks,
+			Size:     f.Size,
+			Complete: f.Complete,
+			Cid:      id,
+		}
+	}
+	return &pb.UploadSession{
+		Id:        session.ID,
+		Thread:    session.Thread.String(),
+		Key:       session.Key,
+		Root:      root,
+		Files:     files,
+		UpdatedAt: session.UpdatedAt.UnixNano(),
+	}
+}
+
+func UploadSessionFromPb(session *pb.UploadSession) (*buckets.UploadSession, error) {
+	id, err := thread.Decode(session.Thread)
+	if err != nil {
+		return nil, fmt.Errorf("decoding thread: %v", err)
+	}
+	var root path.Resolved
+	if len(session.Root) != 0 {
+		root, err = util.NewResolvedPath(session.Root)
+		if err != nil {
+			return nil, fmt.Errorf("resolving root path: %v", err)
+		}
+	}
+	files := make(map[string]buckets.UploadFile)
+	for p, f := range session.Files {
+		chunks := make([]c.Cid, len(f.Chunks))
+		for i, ch := range f.Chunks {
+			chunks[i], err = c.Decode(ch)
+			if err != nil {
+				return nil, fmt.Errorf("decoding chunk cid: %v", err)
+			}
+		}
+		var fc c.Cid
+		if len(f.Cid) != 0 {
+			fc, err = c.Decode(f.Cid)
+			if err != nil {
+				return nil, fmt.Errorf("decoding cid: %v", err)
+			}
+		}
+		files[p] = buckets.UploadFile{
+			Chunks:   chunks,
+			Size:     f.Size,
+			Complete: f.Complete,
+			Cid:      fc,
+		}
+	}
+	return &buckets.UploadSession{
+		ID:        session.Id,
+		Thread:    id,
+		Key:       session.Key,
+		Root:      root,
+		Files:     files,
+		UpdatedAt: time.Unix(0, session.UpdatedAt),
+	}, nil
+}
+
+func SearchQueryToPb(query buckets.SearchQuery) *pb.SearchRequest {
+	var pt pb.SearchItemType
+	switch query.Type {
+	case buckets.SearchFiles:
+		pt = pb.SearchItemType_SEARCH_ITEM_TYPE_FILE
+	case buckets.SearchDirs:
+		pt = pb.SearchItemType_SEARCH_ITEM_TYPE_DIR
+	default:
+		pt = pb.SearchItemType_SEARCH_ITEM_TYPE_UNSPECIFIED
+	}
+	var after, before int64
+	if !query.UpdatedAfter.IsZero() {
+		after = query.UpdatedAfter.UnixNano()
+	}
+	if !query.UpdatedBefore.IsZero() {
+		before = query.UpdatedBefore.UnixNano()
+	}
+	return &pb.SearchRequest{
+		Path:          query.Path,
+		Name:          query.Name,
+		Type:          pt,
+		MinSize:       query.MinSize,
+		MaxSize:       query.MaxSize,
+		UpdatedAfter:  after,
+		UpdatedBefore: before,
+		Attributes:    query.Attributes,
+		Limit:         int32(query.Limit),
+		Cursor:        query.Cursor,
+	}
+}
+
+func SearchQueryFromPb(req *pb.SearchRequest) buckets.SearchQuery {
+	var t buckets.SearchItemType
+	switch req.Type {
+	case pb.SearchItemType_SEARCH_ITEM_TYPE_FILE:
+		t = buckets.SearchFiles
+	case pb.SearchItemType_SEARCH_ITEM_TYPE_DIR:
+		t = buckets.SearchDirs
+	default:
+		t = buckets.SearchAny
+	}
+	var after, before time.Time
+	if req.UpdatedAfter != 0 {
+		after = time.Unix(0, req.UpdatedAfter)
+	}
+	if req.UpdatedBefore != 0 {
+		before = time.Unix(0, req.UpdatedBefore)
+	}
+	return buckets.SearchQuery{
+		Path:          req.Path,
+		Name:          req.Name,
+		Type:          t,
+		MinSize:       req.MinSize,
+		MaxSize:       req.MaxSize,
+		UpdatedAfter:  after,
+		UpdatedBefore: before,
+		Attributes:    req.Attributes,
+		Limit:         int(req.Limit),
+		Cursor:        req.Cursor,
+	}
+}
+
+func ArchiveFormatToPb(format buckets.ArchiveFormat) pb.ArchiveFormat {
+	switch format {
+	case buckets.ArchiveTar:
+		return pb.ArchiveFormat_ARCHIVE_FORMAT_TAR
+	case buckets.ArchiveTarGz:
+		return pb.ArchiveFormat_ARCHIVE_FORMAT_TAR_GZ
+	case buckets.ArchiveZip:
+		return pb.ArchiveFormat_ARCHIVE_FORMAT_ZIP
+	default:
+		return pb.ArchiveFormat_ARCHIVE_FORMAT_UNSPECIFIED
+	}
+}
+
+func ArchiveFormatFromPb(format pb.ArchiveFormat) (buckets.ArchiveFormat, error) {
+	switch format {
+	case pb.ArchiveFormat_ARCHIVE_FORMAT_UNSPECIFIED, pb.ArchiveFormat_ARCHIVE_FORMAT_TAR:
+		return buckets.ArchiveTar, nil
+	case pb.ArchiveFormat_ARCHIVE_FORMAT_TAR_GZ:
+		return buckets.ArchiveTarGz, nil
+	case pb.ArchiveFormat_ARCHIVE_FORMAT_ZIP:
+		return buckets.ArchiveZip, nil
+	default:
+		return 0, fmt.Errorf("invalid archive format: %s", format)
+	}
+}
+
+func UsageToPb(usage *quota.Usage) *pb.Usage {
+	return &pb.Usage{
+		Identity:         string(usage.Identity),
+		StorageUsed:      usage.StorageUsed,
+		StorageLimit:     usage.StorageLimit,
+		StorageAvailable: usage.StorageAvailable,
+		UpdatedAt:        usage.UpdatedAt.UnixNano(),
+	}
+}
+
+func UsageFromPb(usage *pb.Usage) *quota.Usage {
+	return &quota.Usage{
+		Identity:         did.DID(usage.Identity),
+		StorageUsed:      usage.StorageUsed,
+		StorageLimit:     usage.StorageLimit,
+		StorageAvailable: usage.StorageAvailable,
+		UpdatedAt:        time.Unix(0, usage.UpdatedAt),
+	}
+}
+
+func GroupToPb(group *buckets.Group) *pb.Group {
+	return &pb.Group{
+		Thread:    group.Thread.String(),
+		Name:      group.Name,
+		Owner:     string(group.Owner),
+		Members:   MembersToPb(group.Members),
+		CreatedAt: group.CreatedAt,
+		UpdatedAt: group.UpdatedAt,
+	}
+}
+
+func GroupFromPb(group *pb.Group) (*buckets.Group, error) {
+	id, err := thread.Decode(group.Thread)
+	if err != nil {
+		return nil, fmt.Errorf("decoding thread: %v", err)
+	}
+	return &buckets.Group{
+		Thread: id,
+		Group: collection.Group{
+			Name:      group.Name,
+			Owner:     did.DID(group.Owner),
+			Members:   MembersFromPb(group.Members),
+			CreatedAt: group.CreatedAt,
+			UpdatedAt: group.UpdatedAt,
+		},
+	}, nil
+}
+
+func MembersToPb(members []did.DID) []string {
+	pmembers := make([]string, len(members))
+	for i, m := range members {
+		pmembers[i] = string(m)
+	}
+	return pmembers
+}
+
+func MembersFromPb(members []string) []did.DID {
+	dmembers := make([]did.DID, len(members))
+	for i, m := range members {
+		dmembers[i] = did.DID(m)
+	}
+	return dmembers
+}
+
+func ShareLinkToPb(link *share.Link, url string) *pb.ShareLink {
+	return &pb.ShareLink{
+		Id:           link.ID,
+		Thread:       link.Thread.String(),
+		Key:          link.Key,
+		Path:         link.Path,
+		Issuer:       string(link.Issuer),
+		ExpiresAt:    link.ExpiresAt.UnixNano(),
+		MaxDownloads: int32(link.MaxDownloads),
+		Downloads:    int32(link.Downloads),
+		CreatedAt:    link.CreatedAt.UnixNano(),
+		Url:          url,
+	}
+}
+
+func EventTypeToPb(t buckets.EventType) pb.EventType {
+	switch t {
+	case buckets.EventPathAdded:
+		return pb.EventType_EVENT_TYPE_PATH_ADDED
+	case buckets.EventPathModified:
+		return pb.EventType_EVENT_TYPE_PATH_MODIFIED
+	case buckets.EventPathRemoved:
+		return pb.EventType_EVENT_TYPE_PATH_REMOVED
+	case buckets.EventPathMoved:
+		return pb.EventType_EVENT_TYPE_PATH_MOVED
+	case buckets.EventRolesChanged:
+		return pb.EventType_EVENT_TYPE_ROLES_CHANGED
+	case buckets.EventAttributesChanged:
+		return pb.EventType_EVENT_TYPE_ATTRIBUTES_CHANGED
+	case buckets.EventRootReplaced:
+		return pb.EventType_EVENT_TYPE_ROOT_REPLACED
+	case buckets.EventBucketRemoved:
+		return pb.EventType_EVENT_TYPE_BUCKET_REMOVED
+	default:
+		return pb.EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func EventTypeFromPb(t pb.EventType) (buckets.EventType, error) {
+	switch t {
+	case pb.EventType_EVENT_TYPE_PATH_ADDED:
+		return buckets.EventPathAdded, nil
+	case pb.EventType_EVENT_TYPE_PATH_MODIFIED:
+		return buckets.EventPathModified, nil
+	case pb.EventType_EVENT_TYPE_PATH_REMOVED:
+		return buckets.EventPathRemoved, nil
+	case pb.EventType_EVENT_TYPE_PATH_MOVED:
+		return buckets.EventPathMoved, nil
+	case pb.EventType_EVENT_TYPE_ROLES_CHANGED:
+		return buckets.EventRolesChanged, nil
+	case pb.EventType_EVENT_TYPE_ATTRIBUTES_CHANGED:
+		return buckets.EventAttributesChanged, nil
+	case pb.EventType_EVENT_TYPE_ROOT_REPLACED:
+		return buckets.EventRootReplaced, nil
+	case pb.EventType_EVENT_TYPE_BUCKET_REMOVED:
+		return buckets.EventBucketRemoved, nil
+	default:
+		return 0, fmt.Errorf("invalid event type: %s", t)
+	}
+}
+
+func EventToPb(e buckets.Event) *pb.ListenResponse {
+	var bucket *pb.Bucket
+	if e.Bucket != nil {
+		bucket = BucketToPb(e.Bucket)
+	}
+	return &pb.ListenResponse{
+		Bucket:   bucket,
+		Type:     EventTypeToPb(e.Type),
+		Thread:   e.Thread.String(),
+		Key:      e.Key,
+		Path:     e.Path,
+		ToPath:   e.ToPath,
+		Identity: string(e.Identity),
+		OldRoot:  e.OldRoot,
+		NewRoot:  e.NewRoot,
+		Time:     e.Time.UnixNano(),
+	}
+}
+
+func EventFromPb(res *pb.ListenResponse) (*buckets.Event, error) {
+	t, err := EventTypeFromPb(res.Type)
+	if err != nil {
+		return nil, err
+	}
+	id, err := thread.Decode(res.Thread)
+	if err != nil {
+		return nil, fmt.Errorf("decoding thread: %v", err)
+	}
+	var bucket *buckets.Bucket
+	if res.Bucket != nil {
+		b, err := BucketFromPb(res.Bucket)
+		if err != nil {
+			return nil, err
+		}
+		bucket = &b
+	}
+	return &buckets.Event{
+		Type:     t,
+		Thread:   id,
+		Key:      res.Key,
+		Path:     res.Path,
+		ToPath:   res.ToPath,
+		Identity: did.DID(res.Identity),
+		OldRoot:  res.OldRoot,
+		NewRoot:  res.NewRoot,
+		Time:     time.Unix(0, res.Time),
+		Bucket:   bucket,
+	}, nil
+}
+
+func AuditQueryToPb(q audit.Query) *pb.ListAuditRecordsRequest {
+	var since, until int64
+	if !q.Since.IsZero() {
+		since = q.Since.UnixNano()
+	}
+	if !q.Until.IsZero() {
+		until = q.Until.UnixNano()
+	}
+	return &pb.ListAuditRecordsRequest{
+		Key:      q.Key,
+		Identity: string(q.Identity),
+		Since:    since,
+		Until:    until,
+		Limit:    int32(q.Limit),
+	}
+}
+
+func AuditQueryFromPb(req *pb.ListAuditRecordsRequest) audit.Query {
+	var since, until time.Time
+	if req.Since != 0 {
+		since = time.Unix(0, req.Since)
+	}
+	if req.Until != 0 {
+		until = time.Unix(0, req.Until)
+	}
+	return audit.Query{
+		Key:      req.Key,
+		Identity: did.DID(req.Identity),
+		Since:    since,
+		Until:    until,
+		Limit:    int(req.Limit),
+	}
+}
+
+func AuditRecordsToPb(records []*audit.Record) []*pb.AuditRecord {
+	prs := make([]*pb.AuditRecord, len(records))
+	for i, r := range records {
+		prs[i] = &pb.AuditRecord{
+			Id:          r.ID,
+			Thread:      r.Thread.String(),
+			Key:         r.Key,
+			Identity:    string(r.Identity),
+			Operation:   string(r.Operation),
+			Paths:       r.Paths,
+			OldRoot:     r.OldRoot,
+			NewRoot:     r.NewRoot,
+			PinnedDelta: r.PinnedDelta,
+			Time:        r.Time.UnixNano(),
+		}
+	}
+	return prs
+}
+
+func AuditRecordsFromPb(prs []*pb.AuditRecord) ([]*audit.Record, error) {
+	records := make([]*audit.Record, len(prs))
+	for i, r := range prs {
+		id, err := thread.Decode(r.Thread)
+		if err != nil {
+			return nil, fmt.Errorf("decoding thread: %v", err)
+		}
+		records[i] = &audit.Record{
+			ID:          r.Id,
+			Thread:      id,
+			Key:         r.Key,
+			Identity:    did.DID(r.Identity),
+			Operation:   audit.Operation(r.Operation),
+			Paths:       r.Paths,
+			OldRoot:     r.OldRoot,
+			NewRoot:     r.NewRoot,
+			PinnedDelta: r.PinnedDelta,
+			Time:        time.Unix(0, r.Time),
+		}
+	}
+	return records, nil
+}
+
+func VerifyReportToPb(report *buckets.VerifyReport) *pb.VerifyResponse {
+	items := make([]*pb.VerifyResponse_Item, len(report.Items))
+	for i, item := range report.Items {
+		items[i] = &pb.VerifyResponse_Item{
+			Path:  item.Path,
+			Cid:   item.Cid.String(),
+			IsDir: item.IsDir,
+		}
+	}
+	errs := make([]*pb.VerifyResponse_Error, len(report.Errors))
+	for i, e := range report.Errors {
+		errs[i] = &pb.VerifyResponse_Error{
+			Path:   e.Path,
+			Cid:    e.Cid.String(),
+			Reason: e.Reason,
+		}
+	}
+	return &pb.VerifyResponse{
+		Root:    report.Root.String(),
+		Blocks:  int64(report.Blocks),
+		Items:   items,
+		Errors:  errs,
+		Skipped: report.Skipped,
+	}
+}
+
+func VerifyReportFromPb(res *pb.VerifyResponse) (*buckets.VerifyReport, error) {
+	root, err := c.Decode(res.Root)
+	if err != nil {
+		return nil, fmt.Errorf("decoding root: %v", err)
+	}
+	items := make([]buckets.VerifyItem, len(res.Items))
+	for i, item := range res.Items {
+		id, err := c.Decode(item.Cid)
+		if err != nil {
+			return nil, fmt.Errorf("decoding cid: %v", err)
+		}
+		items[i] = buckets.VerifyItem{
+			Path:  item.Path,
+			Cid:   id,
+			IsDir: item.IsDir,
+		}
+	}
+	errs := make([]buckets.VerifyError, len(res.Errors))
+	for i, e := range res.Errors {
+		id, err := c.Decode(e.Cid)
+		if err != nil {
+			return nil, fmt.Errorf("decoding cid: %v", err)
+		}
+		errs[i] = buckets.VerifyError{
+			Path:   e.Path,
+			Cid:    id,
+			Reason: e.Reason,
+		}
+	}
+	return &buckets.VerifyReport{
+		Root:    root,
+		Blocks:  int(res.Blocks),
+		Items:   items,
+		Errors:  errs,
+		Skipped: res.Skipped,
+	}, nil
+}
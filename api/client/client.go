@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
@@ -17,11 +18,16 @@ import (
 	"github.com/textileio/go-buckets"
 	"github.com/textileio/go-buckets/api/cast"
 	pb "github.com/textileio/go-buckets/api/pb/buckets"
+	"github.com/textileio/go-buckets/audit"
 	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/quota"
+	"github.com/textileio/go-buckets/share"
 	"github.com/textileio/go-buckets/util"
 	"github.com/textileio/go-threads/core/did"
 	core "github.com/textileio/go-threads/core/thread"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -32,6 +38,12 @@ const (
 var (
 	// ErrPushPathQueueClosed indicates the push path is or was closed.
 	ErrPushPathQueueClosed = errors.New("push path queue is closed")
+
+	// MaxPushPathsResumes is the maximum number of times an interrupted PushPaths is resumed.
+	MaxPushPathsResumes = 5
+
+	// PushPathsResumeInterval is the base wait between attempts to resume an interrupted PushPaths.
+	PushPathsResumeInterval = time.Second
 )
 
 // Client provides the client api.
@@ -152,12 +164,101 @@ func (c *Client) Remove(ctx context.Context, thread core.ID, key string) error {
 }
 
 // ListPath returns information about a bucket path.
-func (c *Client) ListPath(ctx context.Context, thread core.ID, key, pth string) (*pb.ListPathResponse, error) {
-	return c.c.ListPath(ctx, &pb.ListPathRequest{
+// One level of nested items is listed by default, use buckets.WithListDepth to list more.
+// Use buckets.WithListLimit and buckets.WithListCursor to page through nested items.
+func (c *Client) ListPath(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	opts ...buckets.ListPathOption,
+) (*pb.ListPathResponse, error) {
+	return c.c.ListPath(ctx, newListPathRequest(thread, key, pth, opts))
+}
+
+// ListPathStream streams items below the bucket path in depth-first name order.
+// One level is listed by default, use buckets.WithListDepth to list nested items.
+// Streamed items do not contain nested items.
+func (c *Client) ListPathStream(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	opts ...buckets.ListPathOption,
+) (*ListPathIterator, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.c.ListPathStream(ctx, newListPathRequest(thread, key, pth, opts))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &ListPathIterator{
+		stream: stream,
+		cancel: cancel,
+	}, nil
+}
+
+func newListPathRequest(thread core.ID, key, pth string, opts []buckets.ListPathOption) *pb.ListPathRequest {
+	args := &buckets.ListPathOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	return &pb.ListPathRequest{
 		Thread: thread.String(),
 		Key:    key,
 		Path:   filepath.ToSlash(pth),
-	})
+		Depth:  int32(args.Depth),
+		Limit:  int32(args.Limit),
+		Cursor: args.Cursor,
+	}
+}
+
+// ListPathIterator iterates over items from ListPathStream.
+type ListPathIterator struct {
+	stream pb.APIService_ListPathStreamClient
+	cancel context.CancelFunc
+	item   *pb.PathItem
+	cursor string
+	err    error
+}
+
+// Next returns whether or not another item is available.
+// Check Err after Next returns false.
+func (i *ListPathIterator) Next() bool {
+	if i.err != nil {
+		return false
+	}
+	rep, err := i.stream.Recv()
+	if err == io.EOF {
+		i.cancel()
+		return false
+	} else if err != nil {
+		i.err = err
+		i.cancel()
+		return false
+	}
+	i.item = rep.Item
+	i.cursor = rep.Cursor
+	return true
+}
+
+// Item returns the current item.
+func (i *ListPathIterator) Item() *pb.PathItem {
+	return i.item
+}
+
+// Cursor returns the current item cursor.
+// Pass the cursor to buckets.WithListCursor to resume listing after the current item.
+func (i *ListPathIterator) Cursor() string {
+	return i.cursor
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (i *ListPathIterator) Err() error {
+	return i.err
+}
+
+// Close stops the stream.
+func (i *ListPathIterator) Close() {
+	i.cancel()
 }
 
 // ListIpfsPath returns items at a particular path in a UnixFS path living in the IPFS network.
@@ -167,6 +268,22 @@ func (c *Client) ListIpfsPath(ctx context.Context, pth path.Path) (*pb.ListIpfsP
 	})
 }
 
+// Search returns a page of bucket items that match query.
+// Pass the returned cursor in the next query to fetch the next page.
+// An empty cursor indicates there are no more results.
+func (c *Client) Search(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	query buckets.SearchQuery,
+) (*pb.SearchResponse, error) {
+	req := cast.SearchQueryToPb(query)
+	req.Thread = thread.String()
+	req.Key = key
+	req.Path = filepath.ToSlash(req.Path)
+	return c.c.Search(ctx, req)
+}
+
 // PushPathsResult contains the result of a Push.
 type PushPathsResult struct {
 	Path   string
@@ -179,31 +296,76 @@ type PushPathsResult struct {
 }
 
 // PushPathsQueue handles PushPath input and output.
+// Interrupted uploads are automatically resumed using an upload session.
 type PushPathsQueue struct {
 	// Current contains the current push result.
 	Current PushPathsResult
 
-	q           []pushPath
-	len         int
-	inCh        chan pushPath
-	inWaitCh    chan struct{}
-	outCh       chan PushPathsResult
-	started     bool
-	stopped     bool
-	closeFunc   func() error
-	closed      bool
-	closeWaitCh chan struct{}
+	q        []*pushPath
+	len      int
+	inCh     chan *pushPath
+	inWaitCh chan struct{}
+	outCh    chan PushPathsResult
+	started  bool
+	closed   bool
+	doneCh   chan struct{}
 
+	session  string
 	size     int64
 	complete int64
 
 	lk sync.Mutex
-	wg sync.WaitGroup
 }
 
 type pushPath struct {
 	path string
-	r    func() (io.ReadCloser, error)
+	open func() (io.ReadCloser, error)
+
+	r    io.ReadCloser
+	pos  int64 // Current position of r
+	sent int64 // Max bytes sent, used for progress
+}
+
+// seek positions the reader at offset, opening it if needed.
+func (p *pushPath) seek(offset int64) error {
+	if p.r == nil {
+		r, err := p.open()
+		if err != nil {
+			return err
+		}
+		p.r = r
+	}
+	if offset == p.pos {
+		return nil
+	}
+	if s, ok := p.r.(io.Seeker); ok {
+		if _, err := s.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		p.pos = offset
+		return nil
+	}
+	if offset < p.pos {
+		return fmt.Errorf("cannot resume %s: reader is not seekable", p.path)
+	}
+	n, err := io.CopyN(ioutil.Discard, p.r, offset-p.pos)
+	p.pos += n
+	return err
+}
+
+func (p *pushPath) close() {
+	if p.r != nil {
+		p.r.Close()
+		p.r = nil
+	}
+}
+
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error {
+	return nil
 }
 
 // AddFile adds a file to the queue.
@@ -232,9 +394,9 @@ func (c *PushPathsQueue) AddFile(pth, name string) error {
 
 	atomic.AddInt64(&c.size, info.Size())
 	f.Close()
-	c.q = append(c.q, pushPath{
+	c.q = append(c.q, &pushPath{
 		path: filepath.ToSlash(pth),
-		r: func() (io.ReadCloser, error) {
+		open: func() (io.ReadCloser, error) {
 			return os.Open(name)
 		},
 	})
@@ -243,7 +405,7 @@ func (c *PushPathsQueue) AddFile(pth, name string) error {
 
 // AddReader adds a reader to the queue.
 // pth is the location relative to the bucket root at which to insert the file, e.g., "/path/to/mybone.jpg".
-// r is the reader to read from.
+// r is the reader to read from. Interrupted uploads can only be resumed if r is also an io.Seeker.
 // size is the size of the reader. Use of the WithProgress option is not recommended if the reader size is unknown.
 func (c *PushPathsQueue) AddReader(pth string, r io.Reader, size int64) error {
 	c.lk.Lock()
@@ -257,9 +419,12 @@ func (c *PushPathsQueue) AddReader(pth string, r io.Reader, size int64) error {
 	}
 
 	atomic.AddInt64(&c.size, size)
-	c.q = append(c.q, pushPath{
+	c.q = append(c.q, &pushPath{
 		path: filepath.ToSlash(pth),
-		r: func() (io.ReadCloser, error) {
+		open: func() (io.ReadCloser, error) {
+			if rs, ok := r.(io.ReadSeeker); ok {
+				return nopSeekCloser{rs}, nil
+			}
 			return ioutil.NopCloser(r), nil
 		},
 	})
@@ -276,6 +441,12 @@ func (c *PushPathsQueue) Complete() int64 {
 	return atomic.LoadInt64(&c.complete)
 }
 
+// Session returns the upload session ID.
+// Use WithResumeUploadSession to resume the session with a new queue.
+func (c *PushPathsQueue) Session() string {
+	return c.session
+}
+
 // Next blocks while the queue is open, returning true when a result is ready.
 // Use Current to access the result.
 func (c *PushPathsQueue) Next() (ok bool) {
@@ -323,7 +494,7 @@ func (c *PushPathsQueue) start() {
 				c.lk.Unlock()
 				return
 			}
-			var p pushPath
+			var p *pushPath
 			p, c.q = c.q[0], c.q[1:]
 			c.lk.Unlock()
 			c.inCh <- p
@@ -331,12 +502,6 @@ func (c *PushPathsQueue) start() {
 	}()
 }
 
-func (c *PushPathsQueue) stop() {
-	c.lk.Lock()
-	defer c.lk.Unlock()
-	c.stopped = true
-}
-
 // Err returns the current queue error.
 // Call this method before checking the value of Current.
 func (c *PushPathsQueue) Err() error {
@@ -358,21 +523,14 @@ func (c *PushPathsQueue) Close() error {
 
 	<-c.inWaitCh
 	close(c.inCh)
-
-	c.lk.Lock()
-	wait := !c.stopped
-	c.lk.Unlock()
-	if err := c.closeFunc(); err != nil {
-		return err
-	}
-	if wait {
-		<-c.closeWaitCh
-	}
+	<-c.doneCh
 	return nil
 }
 
 // PushPaths returns a queue that can be used to push multiple files and readers to bucket paths.
 // See PushPathQueue.AddFile and PushPathsQueue.AddReader for more.
+// Uploads are recorded in an upload session, which is used to resume the upload if the connection is interrupted.
+// Use WithResumeUploadSession to resume a session started by another queue.
 func (c *Client) PushPaths(
 	ctx context.Context,
 	thread core.ID,
@@ -384,134 +542,388 @@ func (c *Client) PushPaths(
 		opt(args)
 	}
 
-	stream, err := c.c.PushPaths(ctx)
+	var session *buckets.UploadSession
+	var err error
+	if args.UploadSession != "" {
+		session, err = c.GetUploadSession(ctx, thread, key, args.UploadSession)
+	} else {
+		session, err = c.NewUploadSession(ctx, thread, key)
+	}
 	if err != nil {
 		return nil, err
 	}
-	var xr string
-	if args.Root != nil {
-		xr = args.Root.String()
+
+	p := &pushPathsStream{
+		c:        c,
+		thread:   thread,
+		key:      key,
+		ff:       args.Root != nil,
+		root:     args.Root,
+		progress: args.Progress,
+		files:    session.Files,
+	}
+	if p.ff && session.Root != nil {
+		p.root = session.Root
+	}
+	q := &PushPathsQueue{
+		inCh:     make(chan *pushPath),
+		inWaitCh: make(chan struct{}),
+		outCh:    make(chan PushPathsResult),
+		doneCh:   make(chan struct{}),
+		session:  session.ID,
+	}
+	p.q = q
+
+	// Open the first stream before returning so that connection errors are returned early
+	stream, cancel, err := p.open(ctx)
+	if err != nil {
+		return nil, err
 	}
+	go p.run(ctx, stream, cancel)
+	return q, nil
+}
+
+// pushPathsStream pushes files from a queue,
+// resuming the upload session with a new stream when the current stream is interrupted.
+type pushPathsStream struct {
+	c        *Client
+	q        *PushPathsQueue
+	thread   core.ID
+	key      string
+	ff       bool
+	progress chan<- int64
 
+	root    path.Resolved
+	files   map[string]buckets.UploadFile
+	pending []*pushPath // Files sent but not yet saved
+	inDone  bool
+	lk      sync.Mutex
+}
+
+func (p *pushPathsStream) open(ctx context.Context) (pb.APIService_PushPathsClient, context.CancelFunc, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := p.c.c.PushPaths(ctx)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	var xr string
+	p.lk.Lock()
+	if p.ff && p.root != nil {
+		xr = p.root.String()
+	}
+	p.lk.Unlock()
 	if err := stream.Send(&pb.PushPathsRequest{
 		Payload: &pb.PushPathsRequest_Header_{
 			Header: &pb.PushPathsRequest_Header{
-				Thread: thread.String(),
-				Key:    key,
-				Root:   xr,
+				Thread:    p.thread.String(),
+				Key:       p.key,
+				Root:      xr,
+				SessionId: p.q.session,
 			},
 		},
 	}); err != nil {
-		return nil, err
+		cancel()
+		return nil, nil, err
 	}
+	return stream, cancel, nil
+}
 
-	q := &PushPathsQueue{
-		inCh:     make(chan pushPath),
-		inWaitCh: make(chan struct{}),
-		outCh:    make(chan PushPathsResult),
-		closeFunc: func() error {
-			return stream.CloseSend()
-		},
-		closeWaitCh: make(chan struct{}),
-	}
+func (p *pushPathsStream) run(
+	ctx context.Context,
+	stream pb.APIService_PushPathsClient,
+	cancel context.CancelFunc,
+) {
+	defer close(p.q.doneCh)
+	defer func() {
+		for _, f := range p.pending {
+			f.close()
+		}
+		if !p.inDone {
+			// Unblock the queue so it can be closed
+			go func() {
+				for range p.q.inCh {
+				}
+			}()
+		}
+	}()
 
-	go func() {
-		defer q.stop()
+	var attempts int
+	for {
+		err := p.push(stream, cancel)
+		if err == nil {
+			return
+		}
+		if strings.Contains(err.Error(), "STREAM_CLOSED") {
+			err = ErrPushPathQueueClosed
+		}
 		for {
-			rep, err := stream.Recv()
-			if err == io.EOF {
-				select {
-				case q.closeWaitCh <- struct{}{}:
-				default:
-				}
+			if !isResumable(err) || attempts >= MaxPushPathsResumes {
+				p.q.outCh <- PushPathsResult{err: err}
 				return
-			} else if err != nil {
-				if strings.Contains(err.Error(), "STREAM_CLOSED") {
-					err = ErrPushPathQueueClosed
-				}
-				q.outCh <- PushPathsResult{err: err}
+			}
+			attempts++
+			select {
+			case <-ctx.Done():
+				p.q.outCh <- PushPathsResult{err: ctx.Err()}
 				return
+			case <-time.After(PushPathsResumeInterval * time.Duration(attempts)):
+			}
+			if err = p.resume(ctx); err != nil {
+				continue
 			}
+			if stream, cancel, err = p.open(ctx); err != nil {
+				continue
+			}
+			break
+		}
+	}
+}
 
-			id, err := cid.Parse(rep.Cid)
-			if err != nil {
-				q.outCh <- PushPathsResult{err: err}
-				return
+// push sends files over stream until the queue is closed and all results have been received.
+// The stream is canceled on return.
+func (p *pushPathsStream) push(stream pb.APIService_PushPathsClient, cancel context.CancelFunc) error {
+	recvErr := make(chan error, 1)
+	recvDone := make(chan struct{})
+	go func() {
+		defer close(recvDone)
+		recvErr <- p.receive(stream)
+	}()
+	defer func() {
+		cancel()
+		<-recvDone // Ensure no more results are received from this stream
+	}()
+
+	// Re-send files from an interrupted stream
+	p.lk.Lock()
+	pending := append([]*pushPath(nil), p.pending...)
+	p.lk.Unlock()
+	for _, f := range pending {
+		if err := p.send(stream, f); err == io.EOF {
+			return <-recvErr // Error is waiting to be received
+		} else if err != nil {
+			return err
+		}
+	}
+
+	for !p.inDone {
+		select {
+		case f, ok := <-p.q.inCh:
+			if !ok {
+				p.inDone = true
+				break
 			}
-			root, err := util.NewResolvedPath(rep.Bucket.Path)
+			done, err := p.skip(f)
 			if err != nil {
-				q.outCh <- PushPathsResult{err: err}
-				return
+				return err
+			} else if done {
+				continue
+			}
+			p.lk.Lock()
+			p.pending = append(p.pending, f)
+			p.lk.Unlock()
+			if err := p.send(stream, f); err == io.EOF {
+				return <-recvErr
+			} else if err != nil {
+				return err
 			}
-			q.outCh <- PushPathsResult{
-				Path:   rep.Path,
-				Cid:    id,
-				Size:   rep.Size,
-				Pinned: rep.Pinned,
-				Root:   root,
+		case err := <-recvErr:
+			if err == nil {
+				err = io.ErrUnexpectedEOF
 			}
+			return err
 		}
-	}()
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	return <-recvErr
+}
 
-	sendChunk := func(c *pb.PushPathsRequest_Chunk) bool {
-		q.lk.Lock()
-		defer q.lk.Unlock()
-		if q.closed {
-			return false
+// skip returns true if the file was already saved by the upload session.
+// Partially received files are positioned at the received offset.
+func (p *pushPathsStream) skip(f *pushPath) (bool, error) {
+	p.lk.Lock()
+	uf, ok := p.files[f.path]
+	root := p.root
+	p.lk.Unlock()
+	if ok && uf.Complete {
+		p.q.outCh <- PushPathsResult{
+			Path: f.path,
+			Cid:  uf.Cid,
+			Size: uf.Size,
+			Root: root,
+		}
+		atomic.AddInt64(&p.q.complete, uf.Size)
+		return true, nil
+	}
+	if ok && uf.Size > 0 {
+		if err := f.seek(uf.Size); err != nil {
+			return false, err
 		}
+		f.sent = uf.Size
+		atomic.AddInt64(&p.q.complete, uf.Size)
+	}
+	return false, nil
+}
 
-		if err := stream.Send(&pb.PushPathsRequest{
-			Payload: &pb.PushPathsRequest_Chunk_{
-				Chunk: c,
-			},
-		}); err == io.EOF {
-			return false // error is waiting to be received with stream.Recv above
-		} else if err != nil {
-			q.outCh <- PushPathsResult{err: err}
-			return false
+// send sends a file over stream, starting from the file's current position.
+func (p *pushPathsStream) send(stream pb.APIService_PushPathsClient, f *pushPath) error {
+	if err := f.seek(f.pos); err != nil {
+		return err
+	}
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := f.r.Read(buf)
+		c := &pb.PushPathsRequest_Chunk{
+			Path:   f.path,
+			Offset: f.pos,
 		}
-		atomic.AddInt64(&q.complete, int64(len(c.Data)))
-		if args.Progress != nil {
-			args.Progress <- q.complete
+		if n > 0 {
+			c.Data = make([]byte, n)
+			copy(c.Data, buf[:n])
+			if err := stream.Send(&pb.PushPathsRequest{
+				Payload: &pb.PushPathsRequest_Chunk_{
+					Chunk: c,
+				},
+			}); err != nil {
+				return err
+			}
+			f.pos += int64(n)
+			if f.pos > f.sent {
+				atomic.AddInt64(&p.q.complete, f.pos-f.sent)
+				f.sent = f.pos
+				if p.progress != nil {
+					p.progress <- atomic.LoadInt64(&p.q.complete)
+				}
+			}
+		} else if err == io.EOF {
+			return stream.Send(&pb.PushPathsRequest{
+				Payload: &pb.PushPathsRequest_Chunk_{
+					Chunk: c,
+				},
+			})
+		} else if err != nil {
+			return err
 		}
-		return true
 	}
+}
 
-	go func() {
-		for p := range q.inCh {
-			r, err := p.r()
-			if err != nil {
-				q.outCh <- PushPathsResult{err: err}
+// receive handles results from stream until the stream ends.
+func (p *pushPathsStream) receive(stream pb.APIService_PushPathsClient) error {
+	for {
+		rep, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		id, err := cid.Parse(rep.Cid)
+		if err != nil {
+			return err
+		}
+		root, err := util.NewResolvedPath(rep.Bucket.Path)
+		if err != nil {
+			return err
+		}
+		p.lk.Lock()
+		p.root = root
+		for i, f := range p.pending {
+			if f.path == rep.Path {
+				f.close()
+				p.pending = append(p.pending[:i], p.pending[i+1:]...)
 				break
 			}
-			buf := make([]byte, chunkSize)
-			for {
-				n, err := r.Read(buf)
-				c := &pb.PushPathsRequest_Chunk{
-					Path: p.path,
-				}
-				if n > 0 {
-					c.Data = make([]byte, n)
-					copy(c.Data, buf[:n])
-					if ok := sendChunk(c); !ok {
-						break
-					}
-				} else if err == io.EOF {
-					sendChunk(c)
-					break
-				} else if err != nil {
-					q.outCh <- PushPathsResult{err: err}
-					break
-				}
+		}
+		p.lk.Unlock()
+		p.q.outCh <- PushPathsResult{
+			Path:   rep.Path,
+			Cid:    id,
+			Size:   rep.Size,
+			Pinned: rep.Pinned,
+			Root:   root,
+		}
+	}
+}
+
+// resume updates pending files from the upload session.
+// Files that were saved are reported as complete. Other files are positioned at the received offset.
+func (p *pushPathsStream) resume(ctx context.Context) error {
+	session, err := p.c.GetUploadSession(ctx, p.thread, p.key, p.q.session)
+	if err != nil {
+		return err
+	}
+
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	p.files = session.Files
+	if session.Root != nil {
+		p.root = session.Root
+	}
+	var pending []*pushPath
+	for _, f := range p.pending {
+		uf := session.Files[f.path]
+		if uf.Complete {
+			f.close()
+			p.q.outCh <- PushPathsResult{
+				Path: f.path,
+				Cid:  uf.Cid,
+				Size: uf.Size,
+				Root: session.Root,
 			}
-			r.Close()
+			continue
 		}
-	}()
+		if err := f.seek(uf.Size); err != nil {
+			return err
+		}
+		pending = append(pending, f)
+	}
+	p.pending = pending
+	return nil
+}
 
-	return q, nil
+// isResumable returns true if err indicates that the connection was interrupted.
+func isResumable(err error) bool {
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	return status.Code(err) == codes.Unavailable
+}
+
+// NewUploadSession starts a new upload session for a bucket.
+// Sessions are used by PushPaths to resume interrupted uploads.
+func (c *Client) NewUploadSession(ctx context.Context, thread core.ID, key string) (*buckets.UploadSession, error) {
+	res, err := c.c.NewUploadSession(ctx, &pb.NewUploadSessionRequest{
+		Thread: thread.String(),
+		Key:    key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cast.UploadSessionFromPb(res.Session)
+}
+
+// GetUploadSession returns an upload session for a bucket.
+// The session describes which files have been saved and how many bytes have been received for the others.
+func (c *Client) GetUploadSession(
+	ctx context.Context,
+	thread core.ID,
+	key, id string,
+) (*buckets.UploadSession, error) {
+	res, err := c.c.GetUploadSession(ctx, &pb.GetUploadSessionRequest{
+		Thread: thread.String(),
+		Key:    key,
+		Id:     id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cast.UploadSessionFromPb(res.Session)
 }
 
 // PullPath pulls the bucket path, writing it to writer if it's a file.
+// Use the WithRange option to pull part of the file.
 func (c *Client) PullPath(
 	ctx context.Context,
 	thread core.ID,
@@ -529,6 +941,8 @@ func (c *Client) PullPath(
 		Thread: thread.String(),
 		Key:    key,
 		Path:   pth,
+		Offset: args.Offset,
+		Length: args.Length,
 	})
 	if err != nil {
 		return err
@@ -553,6 +967,38 @@ func (c *Client) PullPath(
 	}
 }
 
+// PullPathArchive pulls the bucket folder at path and all of its descendants, writing them to writer
+// as an archive of the given format. If path is a file, the archive only contains the file.
+func (c *Client) PullPathArchive(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	writer io.Writer,
+	format buckets.ArchiveFormat,
+) error {
+	pth = filepath.ToSlash(pth)
+	stream, err := c.c.PullPathArchive(ctx, &pb.PullPathArchiveRequest{
+		Thread: thread.String(),
+		Key:    key,
+		Path:   pth,
+		Format: cast.ArchiveFormatToPb(format),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		rep, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if _, err := writer.Write(rep.Chunk); err != nil {
+			return err
+		}
+	}
+}
+
 // PullIpfsPath pulls the path from a remote UnixFS dag, writing it to writer if it's a file.
 func (c *Client) PullIpfsPath(ctx context.Context, pth path.Path, writer io.Writer, opts ...buckets.Option) error {
 	args := &buckets.Options{}
@@ -592,22 +1038,47 @@ func (c *Client) SetPath(
 	thread core.ID,
 	key, pth string,
 	remoteCid cid.Cid,
+	opts ...buckets.Option,
 ) (*pb.SetPathResponse, error) {
-	return c.c.SetPath(ctx, &pb.SetPathRequest{
-		Thread: thread.String(),
-		Key:    key,
-		Path:   filepath.ToSlash(pth),
-		Cid:    remoteCid.String(),
-	})
-}
+	args := &buckets.Options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.Root != nil {
+		xr = args.Root.String()
+	}
+	return c.c.SetPath(ctx, &pb.SetPathRequest{
+		Thread: thread.String(),
+		Key:    key,
+		Path:   filepath.ToSlash(pth),
+		Cid:    remoteCid.String(),
+		Root:   xr,
+	})
+}
 
 // MovePath moves a particular path to another path in the existing IPFS UnixFS DAG.
-func (c *Client) MovePath(ctx context.Context, thread core.ID, key, pth string, dest string) error {
+func (c *Client) MovePath(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	dest string,
+	opts ...buckets.Option,
+) error {
+	args := &buckets.Options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.Root != nil {
+		xr = args.Root.String()
+	}
 	_, err := c.c.MovePath(ctx, &pb.MovePathRequest{
 		Thread:   thread.String(),
 		Key:      key,
 		FromPath: filepath.ToSlash(pth),
 		ToPath:   filepath.ToSlash(dest),
+		Root:     xr,
 	})
 	return err
 }
@@ -649,12 +1120,22 @@ func (c *Client) PushPathAccessRoles(
 	thread core.ID,
 	key, pth string,
 	roles map[did.DID]collection.Role,
+	opts ...buckets.Option,
 ) error {
+	args := &buckets.Options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.Root != nil {
+		xr = args.Root.String()
+	}
 	_, err := c.c.PushPathAccessRoles(ctx, &pb.PushPathAccessRolesRequest{
 		Thread: thread.String(),
 		Key:    key,
 		Path:   filepath.ToSlash(pth),
 		Roles:  cast.RolesToPb(roles),
+		Root:   xr,
 	})
 	return err
 }
@@ -675,3 +1156,531 @@ func (c *Client) PullPathAccessRoles(
 	}
 	return cast.RolesFromPb(res.Roles), nil
 }
+
+// EffectiveAccess explains the access role of an identity at a path.
+// If id is empty, the caller's access is explained.
+func (c *Client) EffectiveAccess(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	id did.DID,
+) (*buckets.AccessReport, error) {
+	res, err := c.c.EffectiveAccess(ctx, &pb.EffectiveAccessRequest{
+		Thread:   thread.String(),
+		Key:      key,
+		Path:     filepath.ToSlash(pth),
+		Identity: string(id),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cast.AccessReportFromPb(res), nil
+}
+
+// CreateGroup creates a named group of identities in a thread.
+// Grant the group access roles with collection.GroupPrincipal(name).
+func (c *Client) CreateGroup(ctx context.Context, thread core.ID, name string, members ...did.DID) (*buckets.Group, error) {
+	res, err := c.c.CreateGroup(ctx, &pb.CreateGroupRequest{
+		Thread:  thread.String(),
+		Name:    name,
+		Members: cast.MembersToPb(members),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cast.GroupFromPb(res.Group)
+}
+
+// GetGroup returns a group by name.
+func (c *Client) GetGroup(ctx context.Context, thread core.ID, name string) (*buckets.Group, error) {
+	res, err := c.c.GetGroup(ctx, &pb.GetGroupRequest{
+		Thread: thread.String(),
+		Name:   name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cast.GroupFromPb(res.Group)
+}
+
+// ListGroups returns all groups in a thread.
+func (c *Client) ListGroups(ctx context.Context, thread core.ID) ([]*buckets.Group, error) {
+	res, err := c.c.ListGroups(ctx, &pb.ListGroupsRequest{
+		Thread: thread.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*buckets.Group, len(res.Groups))
+	for i, g := range res.Groups {
+		groups[i], err = cast.GroupFromPb(g)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return groups, nil
+}
+
+// AddGroupMembers adds members to a group.
+func (c *Client) AddGroupMembers(
+	ctx context.Context,
+	thread core.ID,
+	name string,
+	members ...did.DID,
+) (*buckets.Group, error) {
+	res, err := c.c.AddGroupMembers(ctx, &pb.AddGroupMembersRequest{
+		Thread:  thread.String(),
+		Name:    name,
+		Members: cast.MembersToPb(members),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cast.GroupFromPb(res.Group)
+}
+
+// RemoveGroupMembers removes members from a group.
+func (c *Client) RemoveGroupMembers(
+	ctx context.Context,
+	thread core.ID,
+	name string,
+	members ...did.DID,
+) (*buckets.Group, error) {
+	res, err := c.c.RemoveGroupMembers(ctx, &pb.RemoveGroupMembersRequest{
+		Thread:  thread.String(),
+		Name:    name,
+		Members: cast.MembersToPb(members),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cast.GroupFromPb(res.Group)
+}
+
+// DeleteGroup deletes a group.
+func (c *Client) DeleteGroup(ctx context.Context, thread core.ID, name string) error {
+	_, err := c.c.DeleteGroup(ctx, &pb.DeleteGroupRequest{
+		Thread: thread.String(),
+		Name:   name,
+	})
+	return err
+}
+
+// PushPathAttributes updates custom path attributes by merging the pushed attributes with existing attributes.
+// To delete an attribute, set its value to an empty string.
+func (c *Client) PushPathAttributes(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	attrs map[string]string,
+	opts ...buckets.Option,
+) error {
+	args := &buckets.Options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.Root != nil {
+		xr = args.Root.String()
+	}
+	_, err := c.c.PushPathAttributes(ctx, &pb.PushPathAttributesRequest{
+		Thread:     thread.String(),
+		Key:        key,
+		Path:       filepath.ToSlash(pth),
+		Attributes: attrs,
+		Root:       xr,
+	})
+	return err
+}
+
+// PullPathAttributes returns custom attributes for a path.
+func (c *Client) PullPathAttributes(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+) (map[string]string, error) {
+	res, err := c.c.PullPathAttributes(ctx, &pb.PullPathAttributesRequest{
+		Thread: thread.String(),
+		Key:    key,
+		Path:   filepath.ToSlash(pth),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Attributes, nil
+}
+
+// ListVersions returns the bucket's root history, most recent first.
+func (c *Client) ListVersions(ctx context.Context, thread core.ID, key string) ([]collection.Root, error) {
+	res, err := c.c.ListVersions(ctx, &pb.ListVersionsRequest{
+		Thread: thread.String(),
+		Key:    key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cast.VersionsFromPb(res.Versions), nil
+}
+
+// RestoreVersion sets the bucket root to a version from the bucket's history.
+// version can be a root path or cid.
+func (c *Client) RestoreVersion(
+	ctx context.Context,
+	thread core.ID,
+	key, version string,
+	opts ...buckets.Option,
+) (path.Resolved, error) {
+	args := &buckets.Options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.Root != nil {
+		xr = args.Root.String()
+	}
+	res, err := c.c.RestoreVersion(ctx, &pb.RestoreVersionRequest{
+		Thread:  thread.String(),
+		Key:     key,
+		Version: version,
+		Root:    xr,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return util.NewResolvedPath(res.Bucket.Path)
+}
+
+// Fork creates a new bucket from the current root of an existing bucket.
+// The new bucket is created in toThread, which can be the source thread.
+// A new thread is created if toThread is not defined.
+func (c *Client) Fork(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	toThread core.ID,
+	opts ...buckets.ForkOption,
+) (*pb.ForkResponse, error) {
+	args := &buckets.ForkOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var threadstr string
+	if toThread.Defined() {
+		threadstr = toThread.String()
+	}
+	return c.c.Fork(ctx, &pb.ForkRequest{
+		Thread:   thread.String(),
+		Key:      key,
+		ToThread: threadstr,
+		Name:     args.Name,
+		Private:  args.Private,
+		Roles:    args.Roles,
+	})
+}
+
+// RotateKeys re-encrypts a private bucket with a new link key, and optionally new file keys.
+// Previous versions of the bucket can no longer be restored.
+func (c *Client) RotateKeys(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	opts ...buckets.RotateKeysOption,
+) (*pb.RotateKeysResponse, error) {
+	args := &buckets.RotateKeysOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.Root != nil {
+		xr = args.Root.String()
+	}
+	return c.c.RotateKeys(ctx, &pb.RotateKeysRequest{
+		Thread:   thread.String(),
+		Key:      key,
+		FileKeys: args.FileKeys,
+		Root:     xr,
+	})
+}
+
+// SetPrivacy converts a bucket between public and private in place.
+// Previous versions of the bucket can no longer be restored.
+func (c *Client) SetPrivacy(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	private bool,
+	opts ...buckets.Option,
+) (*pb.SetPrivacyResponse, error) {
+	args := &buckets.Options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.Root != nil {
+		xr = args.Root.String()
+	}
+	return c.c.SetPrivacy(ctx, &pb.SetPrivacyRequest{
+		Thread:  thread.String(),
+		Key:     key,
+		Private: private,
+		Root:    xr,
+	})
+}
+
+// Export writes the current bucket dag to writer as a CARv1 archive.
+// Private bucket blocks are written in their encrypted form,
+// and the archive contains the bucket's encryption keys.
+func (c *Client) Export(ctx context.Context, thread core.ID, key string, writer io.Writer) error {
+	stream, err := c.c.Export(ctx, &pb.ExportRequest{
+		Thread: thread.String(),
+		Key:    key,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		rep, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if _, err := writer.Write(rep.Chunk); err != nil {
+			return err
+		}
+	}
+}
+
+// Import creates a new bucket from an archive written by Export.
+// A new thread is created if thread is not defined.
+func (c *Client) Import(
+	ctx context.Context,
+	thread core.ID,
+	reader io.Reader,
+	opts ...buckets.ImportOption,
+) (*pb.ImportResponse, error) {
+	args := &buckets.ImportOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var threadstr string
+	if thread.Defined() {
+		threadstr = thread.String()
+	}
+	stream, err := c.c.Import(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&pb.ImportRequest{
+		Payload: &pb.ImportRequest_Header_{
+			Header: &pb.ImportRequest_Header{
+				Thread:         threadstr,
+				Name:           args.Name,
+				Private:        args.Private,
+				RegenerateKeys: args.RegenerateKeys,
+			},
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.ImportRequest{
+				Payload: &pb.ImportRequest_Chunk{
+					Chunk: buf[:n],
+				},
+			}); err == io.EOF {
+				break // The server closed the stream, its error is returned by CloseAndRecv
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+// Verify walks the remote bucket dag, confirming that every block is retrievable and intact,
+// and that private bucket nodes decrypt with their keys.
+func (c *Client) Verify(ctx context.Context, thread core.ID, key string) (*buckets.VerifyReport, error) {
+	res, err := c.c.Verify(ctx, &pb.VerifyRequest{
+		Thread: thread.String(),
+		Key:    key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cast.VerifyReportFromPb(res)
+}
+
+// CreateShareLink issues a read-only link to a bucket path that expires.
+// The link can be used with the gateway without exposing the caller's identity token.
+// Use share.WithTTL and share.WithMaxDownloads to limit the link.
+func (c *Client) CreateShareLink(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	opts ...share.Option,
+) (*pb.ShareLink, error) {
+	args := &share.Options{
+		TTL: share.DefaultTTL,
+	}
+	for _, opt := range opts {
+		opt(args)
+	}
+	res, err := c.c.CreateShareLink(ctx, &pb.CreateShareLinkRequest{
+		Thread:       thread.String(),
+		Key:          key,
+		Path:         pth,
+		Ttl:          int64(args.TTL),
+		MaxDownloads: int32(args.MaxDownloads),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Link, nil
+}
+
+// ListShareLinks returns the share links the caller has issued for a bucket.
+func (c *Client) ListShareLinks(ctx context.Context, thread core.ID, key string) ([]*pb.ShareLink, error) {
+	res, err := c.c.ListShareLinks(ctx, &pb.ListShareLinksRequest{
+		Thread: thread.String(),
+		Key:    key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Links, nil
+}
+
+// RevokeShareLink revokes a share link issued by the caller.
+func (c *Client) RevokeShareLink(ctx context.Context, thread core.ID, key, id string) error {
+	_, err := c.c.RevokeShareLink(ctx, &pb.RevokeShareLinkRequest{
+		Thread: thread.String(),
+		Key:    key,
+		Id:     id,
+	})
+	return err
+}
+
+// Batch applies a list of operations to a bucket as a single update.
+// The entire batch is rejected if any operation fails.
+func (c *Client) Batch(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	ops []buckets.BatchOp,
+	opts ...buckets.Option,
+) (path.Resolved, error) {
+	args := &buckets.Options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.Root != nil {
+		xr = args.Root.String()
+	}
+	pops := cast.BatchOpsToPb(ops)
+	for _, op := range pops {
+		op.Path = filepath.ToSlash(op.Path)
+		op.ToPath = filepath.ToSlash(op.ToPath)
+	}
+	res, err := c.c.Batch(ctx, &pb.BatchRequest{
+		Thread: thread.String(),
+		Key:    key,
+		Ops:    pops,
+		Root:   xr,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return util.NewResolvedPath(res.Bucket.Path)
+}
+
+// ListAuditRecords returns audit records in a thread matching query, newest first.
+// The owner and admins of the bucket query.Key can query records made by any identity.
+// Otherwise, only records made by the caller are returned.
+func (c *Client) ListAuditRecords(ctx context.Context, thread core.ID, query audit.Query) ([]*audit.Record, error) {
+	req := cast.AuditQueryToPb(query)
+	req.Thread = thread.String()
+	res, err := c.c.ListAuditRecords(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return cast.AuditRecordsFromPb(res.Records)
+}
+
+// ListenEvent describes a bucket change received by Listen.
+type ListenEvent struct {
+	Event *buckets.Event
+	Err   error
+}
+
+// Listen returns a channel of typed bucket change events.
+// Changes to paths the caller can't read are not received.
+// The channel is closed when ctx is canceled, the bucket is removed, or an error occurs,
+// in which case the last event contains the error.
+func (c *Client) Listen(ctx context.Context, thread core.ID, key string) (<-chan ListenEvent, error) {
+	stream, err := c.c.Listen(ctx, &pb.ListenRequest{
+		Thread: thread.String(),
+		Key:    key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make(chan ListenEvent)
+	go func() {
+		defer close(events)
+		for {
+			rep, err := stream.Recv()
+			if err == io.EOF || status.Code(err) == codes.Canceled {
+				return
+			} else if err != nil {
+				events <- ListenEvent{Err: err}
+				return
+			}
+			e, err := cast.EventFromPb(rep)
+			if err != nil {
+				events <- ListenEvent{Err: err}
+				return
+			}
+			events <- ListenEvent{Event: e}
+		}
+	}()
+	return events, nil
+}
+
+// GetUsage returns storage usage for an identity.
+// If identity is empty, usage for the caller is returned.
+// Only admins can get usage for other identities.
+func (c *Client) GetUsage(ctx context.Context, identity did.DID) (*quota.Usage, error) {
+	res, err := c.c.GetUsage(ctx, &pb.GetUsageRequest{
+		Identity: string(identity),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cast.UsageFromPb(res.Usage), nil
+}
+
+// SetUsage sets the storage used and custom storage limit for an identity.
+// A zero limit applies the server's default limit. See quota.Unlimited.
+// Only admins can set usage.
+func (c *Client) SetUsage(ctx context.Context, identity did.DID, used, limit int64) (*quota.Usage, error) {
+	res, err := c.c.SetUsage(ctx, &pb.SetUsageRequest{
+		Identity:     string(identity),
+		StorageUsed:  used,
+		StorageLimit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cast.UsageFromPb(res.Usage), nil
+}
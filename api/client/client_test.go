@@ -1,7 +1,10 @@
 package client_test
 
 import (
+	"archive/tar"
+	"archive/zip"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/rand"
 	"fmt"
@@ -24,7 +27,11 @@ import (
 	"github.com/textileio/go-buckets/api/apitest"
 	"github.com/textileio/go-buckets/api/client"
 	"github.com/textileio/go-buckets/api/common"
+	pb "github.com/textileio/go-buckets/api/pb/buckets"
+	"github.com/textileio/go-buckets/audit"
 	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/quota"
+	"github.com/textileio/go-buckets/share"
 	"github.com/textileio/go-buckets/util"
 	"github.com/textileio/go-threads/core/did"
 	"github.com/textileio/go-threads/core/thread"
@@ -280,6 +287,61 @@ func listPath(t *testing.T, ctx context.Context, c *client.Client, private bool)
 		assert.False(t, rep.Item.IsDir)
 		assert.Equal(t, root.String(), rep.Bucket.Path)
 	})
+
+	t.Run("unlimited depth", func(t *testing.T) {
+		rep, err := c.ListPath(ctx, id, res.Bucket.Key, "", buckets.WithListDepth(buckets.ListDepthUnlimited))
+		require.NoError(t, err)
+		require.Len(t, rep.Item.Items, 3)
+		assert.Equal(t, "dir1", rep.Item.Items[1].Name)
+		require.Len(t, rep.Item.Items[1].Items, 1)
+		assert.Equal(t, "file1.jpg", rep.Item.Items[1].Items[0].Name)
+		assert.Empty(t, rep.Cursor)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		opts := []buckets.ListPathOption{
+			buckets.WithListDepth(buckets.ListDepthUnlimited),
+			buckets.WithListLimit(2),
+		}
+		rep, err := c.ListPath(ctx, id, res.Bucket.Key, "", opts...)
+		require.NoError(t, err)
+		require.Len(t, rep.Item.Items, 2)
+		assert.Len(t, rep.Item.Items[1].Items, 0)
+		assert.Equal(t, "dir1", rep.Cursor)
+
+		rep, err = c.ListPath(ctx, id, res.Bucket.Key, "", append(opts, buckets.WithListCursor(rep.Cursor))...)
+		require.NoError(t, err)
+		require.Len(t, rep.Item.Items, 2)
+		assert.Equal(t, "dir1", rep.Item.Items[0].Name)
+		require.Len(t, rep.Item.Items[0].Items, 1)
+		assert.Equal(t, "dir2", rep.Item.Items[1].Name)
+		assert.Equal(t, "dir2", rep.Cursor)
+
+		rep, err = c.ListPath(ctx, id, res.Bucket.Key, "", append(opts, buckets.WithListCursor(rep.Cursor))...)
+		require.NoError(t, err)
+		require.Len(t, rep.Item.Items, 1)
+		require.Len(t, rep.Item.Items[0].Items, 1)
+		assert.Equal(t, "file2.jpg", rep.Item.Items[0].Items[0].Name)
+		assert.Empty(t, rep.Cursor)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		it, err := c.ListPathStream(ctx, id, res.Bucket.Key, "", buckets.WithListDepth(buckets.ListDepthUnlimited))
+		require.NoError(t, err)
+		defer it.Close()
+		var cursors []string
+		for it.Next() {
+			cursors = append(cursors, it.Cursor())
+		}
+		require.NoError(t, it.Err())
+		assert.Equal(t, []string{
+			collection.SeedName,
+			"dir1",
+			"dir1/file1.jpg",
+			"dir2",
+			"dir2/file2.jpg",
+		}, cursors)
+	})
 }
 
 func TestClient_ListIpfsPath(t *testing.T) {
@@ -312,6 +374,71 @@ func TestClient_ListIpfsPath(t *testing.T) {
 	require.Len(t, r.Item.Items, 2)
 }
 
+func TestClient_Search(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+
+	t.Run("public", func(t *testing.T) {
+		search(t, ctx, c, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		search(t, ctx, c, true)
+	})
+}
+
+func search(t *testing.T, ctx context.Context, c *client.Client, private bool) {
+	res, err := c.Create(ctx, buckets.WithPrivate(private))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	err = q.AddFile("a/file2.jpg", "testdata/file2.jpg")
+	require.NoError(t, err)
+	err = q.AddReader("a/b/note.txt", strings.NewReader("baps!"), 0)
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+
+	rep, err := c.Search(ctx, id, res.Bucket.Key, buckets.SearchQuery{Name: "*.jpg"})
+	require.NoError(t, err)
+	require.Len(t, rep.Items, 2)
+	assert.Equal(t, "file2.jpg", rep.Items[0].Name)
+	assert.Equal(t, "file1.jpg", rep.Items[1].Name)
+	assert.Empty(t, rep.Cursor)
+
+	rep, err = c.Search(ctx, id, res.Bucket.Key, buckets.SearchQuery{Path: "a", Type: buckets.SearchDirs})
+	require.NoError(t, err)
+	require.Len(t, rep.Items, 1)
+	assert.Equal(t, "b", rep.Items[0].Name)
+
+	rep, err = c.Search(ctx, id, res.Bucket.Key, buckets.SearchQuery{Type: buckets.SearchFiles, MaxSize: 1024})
+	require.NoError(t, err)
+	require.Len(t, rep.Items, 1)
+	assert.Equal(t, "note.txt", rep.Items[0].Name)
+
+	// Page through all items
+	var names []string
+	query := buckets.SearchQuery{Limit: 2}
+	for {
+		rep, err = c.Search(ctx, id, res.Bucket.Key, query)
+		require.NoError(t, err)
+		for _, item := range rep.Items {
+			names = append(names, item.Name)
+		}
+		if rep.Cursor == "" {
+			break
+		}
+		query.Cursor = rep.Cursor
+	}
+	assert.Equal(t, []string{"a", "b", "note.txt", "file2.jpg", "file1.jpg"}, names)
+}
+
 func TestClient_PushPaths(t *testing.T) {
 	c := newClient(t)
 	ctx, _ := newIdentityCtx(t, c)
@@ -422,6 +549,104 @@ func pushPaths(t *testing.T, ctx context.Context, c *client.Client, private bool
 	}
 }
 
+func TestClient_UploadSession(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+
+	t.Run("public", func(t *testing.T) {
+		uploadSession(t, ctx, c, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		uploadSession(t, ctx, c, true)
+	})
+}
+
+func uploadSession(t *testing.T, ctx context.Context, c *client.Client, private bool) {
+	res, err := c.Create(ctx, buckets.WithPrivate(private))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	session, err := c.NewUploadSession(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	assert.NotEmpty(t, session.ID)
+	assert.Empty(t, session.Files)
+	before, err := c.GetUsage(ctx, "")
+	require.NoError(t, err)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key, buckets.WithResumeUploadSession(session.ID))
+	require.NoError(t, err)
+	assert.Equal(t, session.ID, q.Session())
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	var file1 client.PushPathsResult
+	for q.Next() {
+		require.NoError(t, q.Err())
+		file1 = q.Current
+	}
+	q.Close()
+
+	// Chunk storage is released when the file is saved
+	after, err := c.GetUsage(ctx, "")
+	require.NoError(t, err)
+	ref, err := c.Create(ctx, buckets.WithPrivate(private))
+	require.NoError(t, err)
+	refBefore, err := c.GetUsage(ctx, "")
+	require.NoError(t, err)
+	q, err = c.PushPaths(ctx, thread.MustDecode(ref.Bucket.Thread), ref.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+	refAfter, err := c.GetUsage(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, refAfter.StorageUsed-refBefore.StorageUsed, after.StorageUsed-before.StorageUsed)
+
+	session, err = c.GetUploadSession(ctx, id, res.Bucket.Key, session.ID)
+	require.NoError(t, err)
+	require.Len(t, session.Files, 1)
+	assert.True(t, session.Files["file1.jpg"].Complete)
+	assert.True(t, session.Files["file1.jpg"].Cid.Equals(file1.Cid))
+	assert.Empty(t, session.Files["file1.jpg"].Chunks)
+	assert.Equal(t, file1.Root.String(), session.Root.String())
+
+	// Resuming the session should skip files that were already saved
+	q2, err := c.PushPaths(
+		ctx,
+		id,
+		res.Bucket.Key,
+		buckets.WithResumeUploadSession(session.ID),
+		buckets.WithFastForwardOnly(file1.Root),
+	)
+	require.NoError(t, err)
+	err = q2.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	err = q2.AddFile("file2.jpg", "testdata/file2.jpg")
+	require.NoError(t, err)
+	var results int
+	for q2.Next() {
+		require.NoError(t, q2.Err())
+		if q2.Current.Path == "file1.jpg" {
+			assert.True(t, q2.Current.Cid.Equals(file1.Cid))
+		}
+		results++
+	}
+	q2.Close()
+	assert.Equal(t, 2, results)
+
+	rep, err := c.ListPath(ctx, id, res.Bucket.Key, "")
+	require.NoError(t, err)
+	assert.Len(t, rep.Item.Items, 3)
+
+	// Unknown sessions cannot be resumed
+	_, err = c.PushPaths(ctx, id, res.Bucket.Key, buckets.WithResumeUploadSession("unknown"))
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), buckets.ErrUploadSessionNotFound.Error()))
+}
+
 func TestClient_PullPath(t *testing.T) {
 	c := newClient(t)
 	ctx, _ := newIdentityCtx(t, c)
@@ -482,6 +707,119 @@ func pullPath(t *testing.T, ctx context.Context, c *client.Client, private bool)
 	err = c.PullPath(ctx, id, res.Bucket.Key, "one/two/note.txt", &buf)
 	require.NoError(t, err)
 	assert.Equal(t, note, buf.String())
+
+	// Pull ranges of the file
+	data, err := ioutil.ReadFile("testdata/file1.jpg")
+	require.NoError(t, err)
+	ranges := [][2]int64{{0, 10}, {1, 15}, {16, 16}, {1000, 0}, {int64(len(data)) - 7, 100}}
+	for _, r := range ranges {
+		var rbuf bytes.Buffer
+		err = c.PullPath(ctx, id, res.Bucket.Key, "file1.jpg", &rbuf, buckets.WithRange(r[0], r[1]))
+		require.NoError(t, err)
+		end := int64(len(data))
+		if r[1] > 0 && r[0]+r[1] < end {
+			end = r[0] + r[1]
+		}
+		assert.Equal(t, data[r[0]:end], rbuf.Bytes())
+	}
+	err = c.PullPath(ctx, id, res.Bucket.Key, "file1.jpg", &buf, buckets.WithRange(int64(len(data))+1, 0))
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), buckets.ErrInvalidRange.Error()))
+}
+
+func TestClient_PullPathArchive(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+
+	t.Run("public", func(t *testing.T) {
+		pullPathArchive(t, ctx, c, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		pullPathArchive(t, ctx, c, true)
+	})
+}
+
+func pullPathArchive(t *testing.T, ctx context.Context, c *client.Client, private bool) {
+	res, err := c.Create(ctx, buckets.WithPrivate(private))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	data, err := ioutil.ReadFile("testdata/file1.jpg")
+	require.NoError(t, err)
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("one/file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	err = q.AddReader("one/two/note.txt", strings.NewReader("baps!"), 0)
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+	want := map[string]string{
+		"file1.jpg":    string(data),
+		"two/":         "",
+		"two/note.txt": "baps!",
+	}
+
+	var buf bytes.Buffer
+	err = c.PullPathArchive(ctx, id, res.Bucket.Key, "one", &buf, buckets.ArchiveTarGz)
+	require.NoError(t, err)
+	gz, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	got := make(map[string]string)
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		got[hdr.Name] = string(content)
+	}
+	assert.Equal(t, want, got)
+
+	buf.Reset()
+	err = c.PullPathArchive(ctx, id, res.Bucket.Key, "one", &buf, buckets.ArchiveZip)
+	require.NoError(t, err)
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	got = make(map[string]string)
+	for _, f := range zr.File {
+		fr, err := f.Open()
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(fr)
+		require.NoError(t, err)
+		fr.Close()
+		got[f.Name] = string(content)
+	}
+	assert.Equal(t, want, got)
+
+	// A single file is archived by name
+	buf.Reset()
+	err = c.PullPathArchive(ctx, id, res.Bucket.Key, "one/two/note.txt", &buf, buckets.ArchiveTar)
+	require.NoError(t, err)
+	tr = tar.NewReader(&buf)
+	hdr, err := tr.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "note.txt", hdr.Name)
+	_, err = tr.Next()
+	assert.Equal(t, io.EOF, err)
+
+	// The root archive excludes the seed
+	buf.Reset()
+	err = c.PullPathArchive(ctx, id, res.Bucket.Key, "", &buf, buckets.ArchiveZip)
+	require.NoError(t, err)
+	zr, err = zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{"one/", "one/file1.jpg", "one/two/", "one/two/note.txt"}, names)
 }
 
 func TestClient_PullIpfsPath(t *testing.T) {
@@ -1065,98 +1403,1429 @@ func pushPathAccessRoles(
 	})
 }
 
-type accessCheck struct {
-	Thread thread.ID
-	Key    string
-	Path   string
-
-	Read  bool
-	Write bool
-	Admin bool
-}
-
-func checkAccess(t *testing.T, ctx context.Context, c *client.Client, check accessCheck) {
-	// Check read access
-	res, err := c.ListPath(ctx, check.Thread, check.Key, check.Path)
-	if check.Read {
-		require.NoError(t, err)
-		assert.NotEmpty(t, res.Item)
-	} else {
-		require.Error(t, err)
-	}
-
-	// Check write access
-	tmp, err := ioutil.TempFile("", "")
+func TestClient_EffectiveAccess(t *testing.T) {
+	c := newClient(t)
+	ctx, ownerIdentity := newIdentityCtx(t, c)
+	owner, err := ownerIdentity.GetPublic().DID()
 	require.NoError(t, err)
-	defer tmp.Close()
-	_, err = io.CopyN(tmp, rand.Reader, 1024)
+	_, identity := newIdentityCtx(t, c)
+	user, err := identity.GetPublic().DID()
 	require.NoError(t, err)
-	q, err := c.PushPaths(ctx, check.Thread, check.Key)
+
+	res, err := c.Create(ctx)
 	require.NoError(t, err)
-	err = q.AddReader(check.Path, tmp, 0)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("a/b/file", "testdata/file1.jpg")
 	require.NoError(t, err)
 	for q.Next() {
-		if check.Write {
-			require.NoError(t, q.Err())
-		} else {
-			require.Error(t, q.Err())
-		}
+		require.NoError(t, q.Err())
 	}
 	q.Close()
 
-	// Check admin access (role editing)
-	_, pk, err := crypto.GenerateEd25519Key(rand.Reader)
-	require.NoError(t, err)
-	admin, err := thread.NewLibp2pPubKey(pk).DID()
+	// Public buckets are readable by all
+	report, err := c.EffectiveAccess(ctx, id, res.Bucket.Key, "a/b/file", user)
 	require.NoError(t, err)
-	err = c.PushPathAccessRoles(ctx, check.Thread, check.Key, check.Path, map[did.DID]collection.Role{
-		admin: collection.ReaderRole,
+	assert.Equal(t, collection.ReaderRole, report.Role)
+	assert.Equal(t, "", report.From)
+	assert.Equal(t, did.DID("*"), report.Principal)
+	assert.False(t, report.Owner)
+
+	// The nearest most privileged grant wins
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "a", map[did.DID]collection.Role{
+		user: collection.WriterRole,
 	})
-	if check.Admin {
-		require.NoError(t, err)
-	} else {
-		require.Error(t, err)
+	require.NoError(t, err)
+	report, err = c.EffectiveAccess(ctx, id, res.Bucket.Key, "a/b/file", user)
+	require.NoError(t, err)
+	assert.Equal(t, collection.WriterRole, report.Role)
+	assert.Equal(t, "a", report.From)
+	assert.Equal(t, user, report.Principal)
+	assert.Len(t, report.Grants, 2)
+	var paths []string
+	for _, e := range report.Tree {
+		paths = append(paths, e.Path)
 	}
+	assert.Contains(t, paths, "")
+	assert.Contains(t, paths, "a")
+
+	// Public access doesn't confer admin
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "a/b", map[did.DID]collection.Role{
+		"*": collection.AdminRole,
+	})
+	require.NoError(t, err)
+	report, err = c.EffectiveAccess(ctx, id, res.Bucket.Key, "a/b/file", user)
+	require.NoError(t, err)
+	assert.Equal(t, collection.WriterRole, report.Role)
+	assert.Equal(t, "a/b", report.From)
+
+	// Defaults to the caller
+	report, err = c.EffectiveAccess(ctx, id, res.Bucket.Key, "a/b/file", "")
+	require.NoError(t, err)
+	assert.Equal(t, owner, report.Identity)
+	assert.Equal(t, collection.AdminRole, report.Role)
+	assert.True(t, report.Owner)
+
+	_, err = c.EffectiveAccess(ctx, id, res.Bucket.Key, "missing", user)
+	require.Error(t, err)
 }
 
-func TestClient_PullPathAccessRoles(t *testing.T) {
+func TestClient_Groups(t *testing.T) {
 	c := newClient(t)
 	ctx, _ := newIdentityCtx(t, c)
+	userCtx, identity := newIdentityCtx(t, c)
+	user, err := identity.GetPublic().DID()
+	require.NoError(t, err)
 
-	res, err := c.Create(ctx)
+	res, err := c.Create(ctx, buckets.WithPrivate(true))
 	require.NoError(t, err)
 	id := thread.MustDecode(res.Bucket.Thread)
 
 	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
 	require.NoError(t, err)
-	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	err = q.AddFile("a/file", "testdata/file1.jpg")
 	require.NoError(t, err)
 	for q.Next() {
 		require.NoError(t, q.Err())
 	}
 	q.Close()
 
-	roles, err := c.PullPathAccessRoles(ctx, id, res.Bucket.Key, "file1.jpg")
-	require.NoError(t, err)
-	assert.Len(t, roles, 0)
+	// Granting a role to a missing group fails
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "a", map[did.DID]collection.Role{
+		collection.GroupPrincipal("eng"): collection.WriterRole,
+	})
+	require.Error(t, err)
 
-	_, pk, err := crypto.GenerateEd25519Key(rand.Reader)
+	_, err = c.CreateGroup(ctx, id, "bad/name")
+	require.Error(t, err)
+	group, err := c.CreateGroup(ctx, id, "eng")
 	require.NoError(t, err)
-	reader, err := thread.NewLibp2pPubKey(pk).DID()
+	assert.Equal(t, "eng", group.Name)
+	assert.Empty(t, group.Members)
+
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "a", map[did.DID]collection.Role{
+		collection.GroupPrincipal("eng"): collection.WriterRole,
+	})
 	require.NoError(t, err)
-	roles = map[did.DID]collection.Role{
-		reader: collection.ReaderRole,
+	check := accessCheck{
+		Thread: id,
+		Key:    res.Bucket.Key,
+		Path:   "a/file",
 	}
-	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "file1.jpg", roles)
+	checkAccess(t, userCtx, c, check)
+
+	// Members are granted the group's role
+	group, err = c.AddGroupMembers(ctx, id, "eng", user)
 	require.NoError(t, err)
+	assert.Equal(t, []did.DID{user}, group.Members)
+	check.Read = true
+	check.Write = true
+	checkAccess(t, userCtx, c, check)
 
-	roles, err = c.PullPathAccessRoles(ctx, id, res.Bucket.Key, "file1.jpg")
+	// Only the owner can manage members
+	_, err = c.RemoveGroupMembers(userCtx, id, "eng", user)
+	require.Error(t, err)
+
+	groups, err := c.ListGroups(ctx, id)
 	require.NoError(t, err)
-	assert.Len(t, roles, 1)
+	require.Len(t, groups, 1)
+	assert.Equal(t, "eng", groups[0].Name)
+
+	// Removed members lose access
+	group, err = c.RemoveGroupMembers(ctx, id, "eng", user)
+	require.NoError(t, err)
+	assert.Empty(t, group.Members)
+	check.Read = false
+	check.Write = false
+	checkAccess(t, userCtx, c, check)
+
+	_, err = c.AddGroupMembers(ctx, id, "eng", user)
+	require.NoError(t, err)
+	err = c.DeleteGroup(ctx, id, "eng")
+	require.NoError(t, err)
+	checkAccess(t, userCtx, c, check)
+	_, err = c.GetGroup(ctx, id, "eng")
+	require.Error(t, err)
+}
+
+func TestClient_GroupsOtherOwner(t *testing.T) {
+	c := newClient(t)
+	groupCtx, _ := newIdentityCtx(t, c)
+	ctx, _ := newIdentityCtx(t, c)
+	userCtx, identity := newIdentityCtx(t, c)
+	user, err := identity.GetPublic().DID()
+	require.NoError(t, err)
+
+	res, err := c.Create(groupCtx)
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+	_, err = c.CreateGroup(groupCtx, id, "eng", user)
+	require.NoError(t, err)
+
+	// The bucket is owned by an identity other than the group owner
+	res, err = c.Create(ctx, buckets.WithThread(id), buckets.WithPrivate(true))
+	require.NoError(t, err)
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("a/file", "testdata/file1.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "a", map[did.DID]collection.Role{
+		collection.GroupPrincipal("eng"): collection.ReaderRole,
+	})
+	require.NoError(t, err)
+	check := accessCheck{
+		Thread: id,
+		Key:    res.Bucket.Key,
+		Path:   "a/file",
+		Read:   true,
+	}
+	checkAccess(t, userCtx, c, check)
+
+	// Members removed by the group owner lose access
+	_, err = c.RemoveGroupMembers(groupCtx, id, "eng", user)
+	require.NoError(t, err)
+	check.Read = false
+	checkAccess(t, userCtx, c, check)
+
+	// Members of deleted groups lose access
+	_, err = c.AddGroupMembers(groupCtx, id, "eng", user)
+	require.NoError(t, err)
+	check.Read = true
+	checkAccess(t, userCtx, c, check)
+	err = c.DeleteGroup(groupCtx, id, "eng")
+	require.NoError(t, err)
+	check.Read = false
+	checkAccess(t, userCtx, c, check)
+}
+
+func TestClient_GroupsMixedOwners(t *testing.T) {
+	c := newClient(t)
+	groupCtx, _ := newIdentityCtx(t, c)
+	ctx, _ := newIdentityCtx(t, c)
+	userCtx, identity := newIdentityCtx(t, c)
+	user, err := identity.GetPublic().DID()
+	require.NoError(t, err)
+
+	res, err := c.Create(groupCtx)
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+	_, err = c.CreateGroup(groupCtx, id, "eng", user)
+	require.NoError(t, err)
+	_, err = c.CreateGroup(ctx, id, "ops", user)
+	require.NoError(t, err)
+
+	// The bucket references groups owned by the bucket owner and another identity
+	res, err = c.Create(ctx, buckets.WithThread(id), buckets.WithPrivate(true))
+	require.NoError(t, err)
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("a/file", "testdata/file1.jpg")
+	require.NoError(t, err)
+	err = q.AddFile("b/file", "testdata/file2.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "a", map[did.DID]collection.Role{
+		collection.GroupPrincipal("eng"): collection.WriterRole,
+	})
+	require.NoError(t, err)
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "b", map[did.DID]collection.Role{
+		collection.GroupPrincipal("ops"): collection.ReaderRole,
+	})
+	require.NoError(t, err)
+	checkA := accessCheck{
+		Thread: id,
+		Key:    res.Bucket.Key,
+		Path:   "a/file",
+		Read:   true,
+		Write:  true,
+	}
+	checkB := accessCheck{
+		Thread: id,
+		Key:    res.Bucket.Key,
+		Path:   "b/file",
+		Read:   true,
+	}
+	checkAccess(t, userCtx, c, checkA)
+	checkAccess(t, userCtx, c, checkB)
+
+	// Each group owner can change their own group's members
+	_, err = c.RemoveGroupMembers(groupCtx, id, "eng", user)
+	require.NoError(t, err)
+	checkA.Read = false
+	checkA.Write = false
+	checkAccess(t, userCtx, c, checkA)
+	checkAccess(t, userCtx, c, checkB)
+
+	_, err = c.RemoveGroupMembers(ctx, id, "ops", user)
+	require.NoError(t, err)
+	checkB.Read = false
+	checkAccess(t, userCtx, c, checkB)
+}
+
+type accessCheck struct {
+	Thread thread.ID
+	Key    string
+	Path   string
+
+	Read  bool
+	Write bool
+	Admin bool
+}
+
+func checkAccess(t *testing.T, ctx context.Context, c *client.Client, check accessCheck) {
+	// Check read access
+	res, err := c.ListPath(ctx, check.Thread, check.Key, check.Path)
+	if check.Read {
+		require.NoError(t, err)
+		assert.NotEmpty(t, res.Item)
+	} else {
+		require.Error(t, err)
+	}
+
+	// Check write access
+	tmp, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer tmp.Close()
+	_, err = io.CopyN(tmp, rand.Reader, 1024)
+	require.NoError(t, err)
+	q, err := c.PushPaths(ctx, check.Thread, check.Key)
+	require.NoError(t, err)
+	err = q.AddReader(check.Path, tmp, 0)
+	require.NoError(t, err)
+	for q.Next() {
+		if check.Write {
+			require.NoError(t, q.Err())
+		} else {
+			require.Error(t, q.Err())
+		}
+	}
+	q.Close()
+
+	// Check admin access (role editing)
+	_, pk, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	admin, err := thread.NewLibp2pPubKey(pk).DID()
+	require.NoError(t, err)
+	err = c.PushPathAccessRoles(ctx, check.Thread, check.Key, check.Path, map[did.DID]collection.Role{
+		admin: collection.ReaderRole,
+	})
+	if check.Admin {
+		require.NoError(t, err)
+	} else {
+		require.Error(t, err)
+	}
+}
+
+func TestClient_PullPathAccessRoles(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+
+	res, err := c.Create(ctx)
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+
+	roles, err := c.PullPathAccessRoles(ctx, id, res.Bucket.Key, "file1.jpg")
+	require.NoError(t, err)
+	assert.Len(t, roles, 0)
+
+	_, pk, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	reader, err := thread.NewLibp2pPubKey(pk).DID()
+	require.NoError(t, err)
+	roles = map[did.DID]collection.Role{
+		reader: collection.ReaderRole,
+	}
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "file1.jpg", roles)
+	require.NoError(t, err)
+
+	roles, err = c.PullPathAccessRoles(ctx, id, res.Bucket.Key, "file1.jpg")
+	require.NoError(t, err)
+	assert.Len(t, roles, 1)
+}
+
+func TestClient_PushPathAttributes(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+
+	t.Run("public", func(t *testing.T) {
+		pushPathAttributes(t, ctx, c, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		pushPathAttributes(t, ctx, c, true)
+	})
+}
+
+func pushPathAttributes(t *testing.T, ctx context.Context, c *client.Client, private bool) {
+	res, err := c.Create(ctx, buckets.WithPrivate(private))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("a/file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+
+	attrs, err := c.PullPathAttributes(ctx, id, res.Bucket.Key, "a/file1.jpg")
+	require.NoError(t, err)
+	assert.Len(t, attrs, 0)
+
+	err = c.PushPathAttributes(ctx, id, res.Bucket.Key, "a/file1.jpg", map[string]string{
+		"content-type": "image/jpeg",
+		"tags":         "cats,dogs",
+	})
+	require.NoError(t, err)
+	err = c.PushPathAttributes(ctx, id, res.Bucket.Key, "a/file1.jpg", map[string]string{
+		"tags": "",
+	})
+	require.NoError(t, err)
+	attrs, err = c.PullPathAttributes(ctx, id, res.Bucket.Key, "a/file1.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"content-type": "image/jpeg"}, attrs)
+
+	// Attributes are not inherited
+	attrs, err = c.PullPathAttributes(ctx, id, res.Bucket.Key, "a")
+	require.NoError(t, err)
+	assert.Len(t, attrs, 0)
+
+	// Attributes follow moves
+	err = c.MovePath(ctx, id, res.Bucket.Key, "a", "b")
+	require.NoError(t, err)
+	attrs, err = c.PullPathAttributes(ctx, id, res.Bucket.Key, "b/file1.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"content-type": "image/jpeg"}, attrs)
+	item, err := c.ListPath(ctx, id, res.Bucket.Key, "b/file1.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, "image/jpeg", item.Item.Metadata.Attributes["content-type"])
+
+	// Attributes are removed with paths
+	_, err = c.RemovePath(ctx, id, res.Bucket.Key, "b/file1.jpg")
+	require.NoError(t, err)
+	b, err := c.Get(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	for _, md := range b.Bucket.Metadata {
+		assert.Len(t, md.Attributes, 0)
+	}
+}
+
+func TestClient_RestoreVersion(t *testing.T) {
+	c := newClient(t, buckets.WithPinnedVersions(10))
+	ctx, _ := newIdentityCtx(t, c)
+
+	t.Run("public", func(t *testing.T) {
+		restoreVersion(t, ctx, c, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		restoreVersion(t, ctx, c, true)
+	})
+}
+
+func restoreVersion(t *testing.T, ctx context.Context, c *client.Client, private bool) {
+	res, err := c.Create(ctx, buckets.WithPrivate(private))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+
+	versions, err := c.ListVersions(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, res.Bucket.Path, versions[1].Path)
+	assert.True(t, versions[1].Pinned)
+	pushed := versions[0].Path
+
+	_, err = c.RemovePath(ctx, id, res.Bucket.Key, "file1.jpg")
+	require.NoError(t, err)
+	_, err = c.ListPath(ctx, id, res.Bucket.Key, "file1.jpg")
+	require.Error(t, err)
+
+	// Restore to the version with file1.jpg
+	root, err := c.RestoreVersion(ctx, id, res.Bucket.Key, pushed)
+	require.NoError(t, err)
+	assert.Equal(t, pushed, root.String())
+	_, err = c.ListPath(ctx, id, res.Bucket.Key, "file1.jpg")
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	err = c.PullPath(ctx, id, res.Bucket.Key, "file1.jpg", buf)
+	require.NoError(t, err)
+	file, err := ioutil.ReadFile("testdata/file1.jpg")
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(file, buf.Bytes()))
+
+	versions, err = c.ListVersions(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	require.Len(t, versions, 4)
+	assert.Equal(t, pushed, versions[0].Path)
+
+	// Restoring the current root fails
+	_, err = c.RestoreVersion(ctx, id, res.Bucket.Key, pushed)
+	require.Error(t, err)
+
+	// Restoring with a stale root fails
+	stale, err := util.NewResolvedPath(versions[1].Path)
+	require.NoError(t, err)
+	_, err = c.RestoreVersion(ctx, id, res.Bucket.Key, res.Bucket.Path, buckets.WithFastForwardOnly(stale))
+	require.Error(t, err)
+
+	if !private {
+		return
+	}
+	// Rotating file keys by changing roles retires versions encrypted with the old keys
+	_, pk, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	reader, err := thread.NewLibp2pPubKey(pk).DID()
+	require.NoError(t, err)
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "file1.jpg", map[did.DID]collection.Role{
+		reader: collection.ReaderRole,
+	})
+	require.NoError(t, err)
+	versions, err = c.ListVersions(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	assert.True(t, versions[0].Pinned)
+	for _, v := range versions[1:] {
+		assert.False(t, v.Pinned)
+	}
+	_, err = c.RestoreVersion(ctx, id, res.Bucket.Key, pushed)
+	require.Error(t, err)
+}
+
+func TestClient_RotateKeys(t *testing.T) {
+	c := newClient(t, buckets.WithPinnedVersions(10))
+	ctx, _ := newIdentityCtx(t, c)
+
+	t.Run("link key", func(t *testing.T) {
+		rotateKeys(t, ctx, c, false)
+	})
+
+	t.Run("file keys", func(t *testing.T) {
+		rotateKeys(t, ctx, c, true)
+	})
+
+	t.Run("public", func(t *testing.T) {
+		res, err := c.Create(ctx)
+		require.NoError(t, err)
+		_, err = c.RotateKeys(ctx, thread.MustDecode(res.Bucket.Thread), res.Bucket.Key)
+		require.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), buckets.ErrNotPrivate.Error()))
+	})
+}
+
+func rotateKeys(t *testing.T, ctx context.Context, c *client.Client, fileKeys bool) {
+	res, err := c.Create(ctx, buckets.WithPrivate(true))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	err = q.AddReader("one/two/note.txt", strings.NewReader("baps!"), 0)
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+	before, err := c.Get(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+
+	// Rotating with a stale root fails
+	stale, err := util.NewResolvedPath(res.Bucket.Path)
+	require.NoError(t, err)
+	_, err = c.RotateKeys(ctx, id, res.Bucket.Key, buckets.WithRotateRoot(stale))
+	require.Error(t, err)
+
+	rep, err := c.RotateKeys(ctx, id, res.Bucket.Key, buckets.WithRotateFileKeys(fileKeys))
+	require.NoError(t, err)
+	assert.NotEqual(t, before.Bucket.Path, rep.Bucket.Path)
+	assert.NotEqual(t, before.Bucket.LinkKey, rep.Bucket.LinkKey)
+	assert.NotEmpty(t, rep.Bucket.LinkKey)
+	for p, md := range before.Bucket.Metadata {
+		if md.Key == "" {
+			continue
+		}
+		if fileKeys {
+			assert.NotEqual(t, md.Key, rep.Bucket.Metadata[p].Key)
+		} else {
+			assert.Equal(t, md.Key, rep.Bucket.Metadata[p].Key)
+		}
+	}
+
+	// Content is readable with the new keys
+	buf := new(bytes.Buffer)
+	err = c.PullPath(ctx, id, res.Bucket.Key, "file1.jpg", buf)
+	require.NoError(t, err)
+	file, err := ioutil.ReadFile("testdata/file1.jpg")
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(file, buf.Bytes()))
+	buf.Reset()
+	err = c.PullPath(ctx, id, res.Bucket.Key, "one/two/note.txt", buf)
+	require.NoError(t, err)
+	assert.Equal(t, "baps!", buf.String())
+	report, err := c.Verify(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	assert.True(t, report.OK())
+
+	// Replaced versions are no longer restorable
+	versions, err := c.ListVersions(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	require.Greater(t, len(versions), 2)
+	assert.Equal(t, rep.Bucket.Path, versions[0].Path)
+	assert.True(t, versions[0].Pinned)
+	for _, v := range versions[1:] {
+		assert.False(t, v.Pinned)
+	}
+	_, err = c.RestoreVersion(ctx, id, res.Bucket.Key, before.Bucket.Path)
+	require.Error(t, err)
+}
+
+func TestClient_SetPrivacy(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+
+	res, err := c.Create(ctx)
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	err = q.AddReader("one/two/note.txt", strings.NewReader("baps!"), 0)
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+
+	file, err := ioutil.ReadFile("testdata/file1.jpg")
+	require.NoError(t, err)
+	check := func(private bool) {
+		buf := new(bytes.Buffer)
+		err = c.PullPath(ctx, id, res.Bucket.Key, "file1.jpg", buf)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(file, buf.Bytes()))
+		buf.Reset()
+		err = c.PullPath(ctx, id, res.Bucket.Key, "one/two/note.txt", buf)
+		require.NoError(t, err)
+		assert.Equal(t, "baps!", buf.String())
+		report, err := c.Verify(ctx, id, res.Bucket.Key)
+		require.NoError(t, err)
+		assert.True(t, report.OK())
+		roles, err := c.PullPathAccessRoles(ctx, id, res.Bucket.Key, "")
+		require.NoError(t, err)
+		_, ok := roles["*"]
+		assert.Equal(t, !private, ok)
+	}
+
+	// Making a public bucket public fails
+	_, err = c.SetPrivacy(ctx, id, res.Bucket.Key, false)
+	require.Error(t, err)
+
+	rep, err := c.SetPrivacy(ctx, id, res.Bucket.Key, true)
+	require.NoError(t, err)
+	assert.NotEmpty(t, rep.Bucket.LinkKey)
+	assert.NotEmpty(t, rep.Bucket.Metadata[""].Key)
+	assert.NotEqual(t, res.Bucket.Path, rep.Bucket.Path)
+	check(true)
+
+	// Converting with a stale root fails
+	stale, err := util.NewResolvedPath(res.Bucket.Path)
+	require.NoError(t, err)
+	_, err = c.SetPrivacy(ctx, id, res.Bucket.Key, false, buckets.WithFastForwardOnly(stale))
+	require.Error(t, err)
+
+	rep2, err := c.SetPrivacy(ctx, id, res.Bucket.Key, false)
+	require.NoError(t, err)
+	assert.Empty(t, rep2.Bucket.LinkKey)
+	for _, md := range rep2.Bucket.Metadata {
+		assert.Empty(t, md.Key)
+	}
+	assert.NotEqual(t, rep.Bucket.Path, rep2.Bucket.Path)
+	check(false)
+
+	// Replaced versions are no longer restorable
+	versions, err := c.ListVersions(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	for _, v := range versions[1:] {
+		assert.False(t, v.Pinned)
+	}
+}
+
+func TestClient_ShareLinks(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+	ctx2, _ := newIdentityCtx(t, c)
+
+	res, err := c.Create(ctx, buckets.WithPrivate(true))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddReader("one/two/note.txt", strings.NewReader("baps!"), 0)
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+
+	// Paths must exist and be readable by the issuer
+	_, err = c.CreateShareLink(ctx, id, res.Bucket.Key, "missing")
+	require.Error(t, err)
+	_, err = c.CreateShareLink(ctx2, id, res.Bucket.Key, "one")
+	require.Error(t, err)
+
+	link, err := c.CreateShareLink(
+		ctx,
+		id,
+		res.Bucket.Key,
+		"one",
+		share.WithTTL(time.Minute),
+		share.WithMaxDownloads(1),
+	)
+	require.NoError(t, err)
+	assert.NotEmpty(t, link.Id)
+	assert.Equal(t, "one", link.Path)
+	assert.Equal(t, int32(1), link.MaxDownloads)
+	assert.Contains(t, link.Url, "?share="+link.Id)
+	assert.NotContains(t, link.Url, "token=")
+	assert.True(t, time.Unix(0, link.ExpiresAt).After(time.Now()))
+
+	links, err := c.ListShareLinks(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, link.Id, links[0].Id)
+
+	// Links are only listed for their issuer
+	links, err = c.ListShareLinks(ctx2, id, res.Bucket.Key)
+	require.NoError(t, err)
+	assert.Len(t, links, 0)
+
+	// Only the issuer can revoke a link
+	err = c.RevokeShareLink(ctx2, id, res.Bucket.Key, link.Id)
+	require.Error(t, err)
+	err = c.RevokeShareLink(ctx, id, res.Bucket.Key, link.Id)
+	require.NoError(t, err)
+	links, err = c.ListShareLinks(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	assert.Len(t, links, 0)
+}
+
+func TestClient_Fork(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+
+	t.Run("public to public", func(t *testing.T) {
+		fork(t, ctx, c, false, false)
+	})
+
+	t.Run("public to private", func(t *testing.T) {
+		fork(t, ctx, c, false, true)
+	})
+
+	t.Run("private to private", func(t *testing.T) {
+		fork(t, ctx, c, true, false)
+	})
+}
+
+func fork(t *testing.T, ctx context.Context, c *client.Client, private, toPrivate bool) {
+	res, err := c.Create(ctx, buckets.WithPrivate(private))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	err = q.AddFile("folder1/file2.jpg", "testdata/file2.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+
+	// Fork into the same thread
+	fres, err := c.Fork(ctx, id, res.Bucket.Key, id, buckets.WithForkPrivate(toPrivate))
+	require.NoError(t, err)
+	assert.Equal(t, res.Bucket.Thread, fres.Bucket.Thread)
+	assert.NotEqual(t, res.Bucket.Key, fres.Bucket.Key)
+	assert.NotEqual(t, res.Bucket.Path, fres.Bucket.Path)
+	assert.Equal(t, private || toPrivate, fres.Bucket.LinkKey != "")
+	assert.NotEmpty(t, fres.Links)
+
+	rep, err := c.ListPath(ctx, id, fres.Bucket.Key, "")
+	require.NoError(t, err)
+	assert.Len(t, rep.Item.Items, 3)
+	buf := new(bytes.Buffer)
+	err = c.PullPath(ctx, id, fres.Bucket.Key, "folder1/file2.jpg", buf)
+	require.NoError(t, err)
+	file, err := ioutil.ReadFile("testdata/file2.jpg")
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(file, buf.Bytes()))
+
+	// Changes to the fork don't affect the source
+	_, err = c.RemovePath(ctx, id, fres.Bucket.Key, "file1.jpg")
+	require.NoError(t, err)
+	_, err = c.ListPath(ctx, id, res.Bucket.Key, "file1.jpg")
+	require.NoError(t, err)
+
+	// Removing the source doesn't affect the fork
+	err = c.Remove(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	buf.Reset()
+	err = c.PullPath(ctx, id, fres.Bucket.Key, "folder1/file2.jpg", buf)
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(file, buf.Bytes()))
+
+	// Fork into a new thread
+	res2, err := c.Create(ctx, buckets.WithPrivate(private))
+	require.NoError(t, err)
+	id2 := thread.MustDecode(res2.Bucket.Thread)
+	fres2, err := c.Fork(ctx, id2, res2.Bucket.Key, thread.Undef, buckets.WithForkName("forked"))
+	require.NoError(t, err)
+	assert.NotEqual(t, res2.Bucket.Thread, fres2.Bucket.Thread)
+	assert.Equal(t, "forked", fres2.Bucket.Name)
+}
+
+func TestClient_ExportImport(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+
+	t.Run("public", func(t *testing.T) {
+		exportImport(t, ctx, c, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		exportImport(t, ctx, c, true)
+	})
+}
+
+func exportImport(t *testing.T, ctx context.Context, c *client.Client, private bool) {
+	res, err := c.Create(ctx, buckets.WithName("archived"), buckets.WithPrivate(private))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	err = q.AddFile("folder1/file2.jpg", "testdata/file2.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+	err = c.PushPathAttributes(ctx, id, res.Bucket.Key, "file1.jpg", map[string]string{"foo": "bar"})
+	require.NoError(t, err)
+
+	archive := new(bytes.Buffer)
+	err = c.Export(ctx, id, res.Bucket.Key, archive)
+	require.NoError(t, err)
+	data := archive.Bytes()
+
+	file, err := ioutil.ReadFile("testdata/file2.jpg")
+	require.NoError(t, err)
+	check := func(t *testing.T, ires *pb.ImportResponse) {
+		assert.Equal(t, "archived", ires.Bucket.Name)
+		assert.NotEqual(t, res.Bucket.Key, ires.Bucket.Key)
+		assert.NotEqual(t, res.Bucket.Path, ires.Bucket.Path)
+		assert.Equal(t, private, ires.Bucket.LinkKey != "")
+		assert.NotEmpty(t, ires.Links)
+		iid := thread.MustDecode(ires.Bucket.Thread)
+
+		rep, err := c.ListPath(ctx, iid, ires.Bucket.Key, "")
+		require.NoError(t, err)
+		assert.Len(t, rep.Item.Items, 3)
+		buf := new(bytes.Buffer)
+		err = c.PullPath(ctx, iid, ires.Bucket.Key, "folder1/file2.jpg", buf)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(file, buf.Bytes()))
+		attrs, err := c.PullPathAttributes(ctx, iid, ires.Bucket.Key, "file1.jpg")
+		require.NoError(t, err)
+		assert.Equal(t, "bar", attrs["foo"])
+	}
+
+	t.Run("keep keys", func(t *testing.T) {
+		ires, err := c.Import(ctx, id, bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.Equal(t, res.Bucket.Thread, ires.Bucket.Thread)
+		assert.Equal(t, res.Bucket.LinkKey, ires.Bucket.LinkKey)
+		check(t, ires)
+	})
+
+	t.Run("regenerate keys", func(t *testing.T) {
+		ires, err := c.Import(ctx, thread.Undef, bytes.NewReader(data), buckets.WithImportRegenerateKeys(true))
+		require.NoError(t, err)
+		assert.NotEqual(t, res.Bucket.Thread, ires.Bucket.Thread)
+		if private {
+			assert.NotEqual(t, res.Bucket.LinkKey, ires.Bucket.LinkKey)
+		}
+		check(t, ires)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := c.Import(ctx, id, bytes.NewReader(data[:len(data)/2]))
+		require.Error(t, err)
+	})
+}
+
+func TestClient_FastForwardOnly(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+
+	t.Run("public", func(t *testing.T) {
+		fastForwardOnly(t, ctx, c, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		fastForwardOnly(t, ctx, c, true)
+	})
+}
+
+func fastForwardOnly(t *testing.T, ctx context.Context, c *client.Client, private bool) {
+	res, err := c.Create(ctx, buckets.WithPrivate(private))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+	stale, err := util.NewResolvedPath(res.Bucket.Path)
+	require.NoError(t, err)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	var root path.Resolved
+	for q.Next() {
+		require.NoError(t, q.Err())
+		root = q.Current.Root
+	}
+	q.Close()
+
+	_, pk, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	reader, err := thread.NewLibp2pPubKey(pk).DID()
+	require.NoError(t, err)
+	roles := map[did.DID]collection.Role{reader: collection.ReaderRole}
+
+	// Stale roots are rejected
+	_, err = c.SetPath(ctx, id, res.Bucket.Key, "copy.jpg", root.Cid(), buckets.WithFastForwardOnly(stale))
+	require.Error(t, err)
+	err = c.MovePath(ctx, id, res.Bucket.Key, "file1.jpg", "moved.jpg", buckets.WithFastForwardOnly(stale))
+	require.Error(t, err)
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "file1.jpg", roles, buckets.WithFastForwardOnly(stale))
+	require.Error(t, err)
+	_, err = c.ListPath(ctx, id, res.Bucket.Key, "file1.jpg")
+	require.NoError(t, err)
+
+	// Current roots are accepted
+	err = c.MovePath(ctx, id, res.Bucket.Key, "file1.jpg", "moved.jpg", buckets.WithFastForwardOnly(root))
+	require.NoError(t, err)
+	rep, err := c.ListPath(ctx, id, res.Bucket.Key, "")
+	require.NoError(t, err)
+	root, err = util.NewResolvedPath(rep.Bucket.Path)
+	require.NoError(t, err)
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "moved.jpg", roles, buckets.WithFastForwardOnly(root))
+	require.NoError(t, err)
+}
+
+func TestClient_Batch(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+
+	t.Run("public", func(t *testing.T) {
+		batch(t, ctx, c, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		batch(t, ctx, c, true)
+	})
+}
+
+func batch(t *testing.T, ctx context.Context, c *client.Client, private bool) {
+	res, err := c.Create(ctx, buckets.WithPrivate(private))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	err = q.AddFile("folder1/file2.jpg", "testdata/file2.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+	rep, err := c.ListPath(ctx, id, res.Bucket.Key, "")
+	require.NoError(t, err)
+	root := rep.Bucket.Path
+	versions, err := c.ListVersions(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+
+	// A failed operation rejects the whole batch
+	_, err = c.Batch(ctx, id, res.Bucket.Key, []buckets.BatchOp{
+		{Type: buckets.BatchPutPath, Path: "file3.txt", Data: []byte("hello")},
+		{Type: buckets.BatchRemovePath, Path: "nothing/here"},
+	})
+	require.Error(t, err)
+	rep, err = c.ListPath(ctx, id, res.Bucket.Key, "")
+	require.NoError(t, err)
+	assert.Equal(t, root, rep.Bucket.Path)
+	_, err = c.ListPath(ctx, id, res.Bucket.Key, "file3.txt")
+	require.Error(t, err)
+
+	// A stale root rejects the whole batch
+	stale, err := util.NewResolvedPath(res.Bucket.Path)
+	require.NoError(t, err)
+	_, err = c.Batch(ctx, id, res.Bucket.Key, []buckets.BatchOp{
+		{Type: buckets.BatchPutPath, Path: "file3.txt", Data: []byte("hello")},
+	}, buckets.WithFastForwardOnly(stale))
+	require.Error(t, err)
+
+	// All operations result in a single new root
+	current, err := util.NewResolvedPath(root)
+	require.NoError(t, err)
+	pth, err := c.Batch(ctx, id, res.Bucket.Key, []buckets.BatchOp{
+		{Type: buckets.BatchPutPath, Path: "file3.txt", Data: []byte("hello")},
+		{Type: buckets.BatchMovePath, Path: "file1.jpg", ToPath: "folder2/file1.jpg"},
+		{Type: buckets.BatchRemovePath, Path: "folder1"},
+	}, buckets.WithFastForwardOnly(current))
+	require.NoError(t, err)
+	assert.NotEqual(t, root, pth.String())
+
+	bversions, err := c.ListVersions(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	assert.Len(t, bversions, len(versions)+1)
+
+	rep, err = c.ListPath(ctx, id, res.Bucket.Key, "")
+	require.NoError(t, err)
+	assert.Len(t, rep.Item.Items, 3)
+	_, err = c.ListPath(ctx, id, res.Bucket.Key, "folder1")
+	require.Error(t, err)
+	_, err = c.ListPath(ctx, id, res.Bucket.Key, "folder2/file1.jpg")
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	err = c.PullPath(ctx, id, res.Bucket.Key, "file3.txt", buf)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", buf.String())
+
+	// The replaced root stays pinned as a version
+	_, err = c.RestoreVersion(ctx, id, res.Bucket.Key, root)
+	require.NoError(t, err)
+	_, err = c.ListPath(ctx, id, res.Bucket.Key, "folder1/file2.jpg")
+	require.NoError(t, err)
+}
+
+func TestClient_Verify(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+
+	t.Run("public", func(t *testing.T) {
+		verify(t, ctx, c, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		verify(t, ctx, c, true)
+	})
+}
+
+func verify(t *testing.T, ctx context.Context, c *client.Client, private bool) {
+	res, err := c.Create(ctx, buckets.WithPrivate(private))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	err = q.AddFile("folder1/file2.jpg", "testdata/file2.jpg")
+	require.NoError(t, err)
+	var root path.Resolved
+	for q.Next() {
+		require.NoError(t, q.Err())
+		root = q.Current.Root
+	}
+	q.Close()
+
+	report, err := c.Verify(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.Empty(t, report.Errors)
+	assert.Empty(t, report.Skipped)
+	assert.Equal(t, root.Cid(), report.Root)
+	assert.GreaterOrEqual(t, report.Blocks, len(report.Items))
+
+	paths := make(map[string]buckets.VerifyItem)
+	for _, item := range report.Items {
+		paths[item.Path] = item
+	}
+	assert.Len(t, paths, 5)
+	assert.True(t, paths[""].IsDir)
+	assert.True(t, paths["folder1"].IsDir)
+	assert.False(t, paths[collection.SeedName].IsDir)
+
+	// Item cids match listed cids
+	rep, err := c.ListPath(ctx, id, res.Bucket.Key, "folder1/file2.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, rep.Item.Cid, paths["folder1/file2.jpg"].Cid.String())
+	assert.False(t, paths["folder1/file2.jpg"].IsDir)
+}
+
+func TestClient_Audit(t *testing.T) {
+	c := newClient(t)
+	ctx, ownerIdentity := newIdentityCtx(t, c)
+	owner, err := ownerIdentity.GetPublic().DID()
+	require.NoError(t, err)
+	userCtx, identity := newIdentityCtx(t, c)
+	user, err := identity.GetPublic().DID()
+	require.NoError(t, err)
+
+	res, err := c.Create(ctx)
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+	start := time.Now()
+
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("a/file", "testdata/file1.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+	err = c.MovePath(ctx, id, res.Bucket.Key, "a/file", "b/file")
+	require.NoError(t, err)
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "", map[did.DID]collection.Role{
+		user: collection.WriterRole,
+	})
+	require.NoError(t, err)
+
+	q, err = c.PushPaths(userCtx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("c/file", "testdata/file2.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+
+	// The owner can list changes made by any identity, newest first
+	records, err := c.ListAuditRecords(ctx, id, audit.Query{Key: res.Bucket.Key})
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+	assert.Equal(t, audit.OpPushPaths, records[0].Operation)
+	assert.Equal(t, user, records[0].Identity)
+	assert.Equal(t, []string{"c/file"}, records[0].Paths)
+	assert.Greater(t, records[0].PinnedDelta, int64(0))
+	assert.Equal(t, audit.OpPushPathAccessRoles, records[1].Operation)
+	assert.Equal(t, audit.OpMovePath, records[2].Operation)
+	assert.Equal(t, []string{"a/file", "b/file"}, records[2].Paths)
+	assert.Equal(t, records[3].NewRoot, records[2].OldRoot)
+	assert.Equal(t, audit.OpPushPaths, records[3].Operation)
+	assert.Equal(t, owner, records[3].Identity)
+	assert.Equal(t, audit.OpCreate, records[4].Operation)
+	assert.Empty(t, records[4].OldRoot)
+	assert.Equal(t, records[1].NewRoot, records[0].OldRoot)
+
+	records, err = c.ListAuditRecords(ctx, id, audit.Query{Key: res.Bucket.Key, Identity: user})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	records, err = c.ListAuditRecords(ctx, id, audit.Query{Key: res.Bucket.Key, Since: start, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, audit.OpPushPathAccessRoles, records[1].Operation)
+
+	records, err = c.ListAuditRecords(ctx, id, audit.Query{Key: res.Bucket.Key, Until: start})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, audit.OpCreate, records[0].Operation)
+
+	// Other identities can only list their own changes
+	records, err = c.ListAuditRecords(userCtx, id, audit.Query{Key: res.Bucket.Key})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, user, records[0].Identity)
+	_, err = c.ListAuditRecords(userCtx, id, audit.Query{Key: res.Bucket.Key, Identity: owner})
+	require.Error(t, err)
+}
+
+func TestClient_Listen(t *testing.T) {
+	c := newClient(t)
+	ctx, ownerIdentity := newIdentityCtx(t, c)
+	owner, err := ownerIdentity.GetPublic().DID()
+	require.NoError(t, err)
+	userCtx, identity := newIdentityCtx(t, c)
+	user, err := identity.GetPublic().DID()
+	require.NoError(t, err)
+
+	res, err := c.Create(ctx, buckets.WithPrivate(true))
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("shared/file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "shared", map[did.DID]collection.Role{
+		user: collection.ReaderRole,
+	})
+	require.NoError(t, err)
+
+	lctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events, err := c.Listen(lctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	ulctx, ucancel := context.WithCancel(userCtx)
+	defer ucancel()
+	userEvents, err := c.Listen(ulctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+
+	q, err = c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("shared/file1.jpg", "testdata/file2.jpg")
+	require.NoError(t, err)
+	err = q.AddFile("hidden/file2.jpg", "testdata/file2.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+
+	// Pushed files are reported individually
+	pushed := make(map[string]*buckets.Event)
+	for i := 0; i < 2; i++ {
+		e := nextListenEvent(t, events)
+		assert.Equal(t, owner, e.Identity)
+		assert.NotEqual(t, e.OldRoot, e.NewRoot)
+		require.NotNil(t, e.Bucket)
+		assert.Equal(t, e.NewRoot, e.Bucket.Path)
+		pushed[e.Path] = e
+	}
+	require.Contains(t, pushed, "hidden/file2.jpg")
+	assert.Equal(t, buckets.EventPathAdded, pushed["hidden/file2.jpg"].Type)
+	require.Contains(t, pushed, "shared/file1.jpg")
+	assert.Equal(t, buckets.EventPathModified, pushed["shared/file1.jpg"].Type)
+
+	// Changes to paths the listener can't read are skipped
+	e := nextListenEvent(t, userEvents)
+	assert.Equal(t, buckets.EventPathModified, e.Type)
+	assert.Equal(t, "shared/file1.jpg", e.Path)
+
+	// Moves are reported as moves even though moved paths are re-encrypted in private buckets
+	err = c.MovePath(ctx, id, res.Bucket.Key, "hidden/file2.jpg", "shared/file2.jpg")
+	require.NoError(t, err)
+	e = nextListenEvent(t, events)
+	assert.Equal(t, buckets.EventPathMoved, e.Type)
+	assert.Equal(t, "hidden/file2.jpg", e.Path)
+	assert.Equal(t, "shared/file2.jpg", e.ToPath)
+
+	e = nextListenEvent(t, userEvents)
+	assert.Equal(t, buckets.EventPathAdded, e.Type)
+	assert.Equal(t, "shared/file2.jpg", e.Path)
+
+	_, err = c.RemovePath(ctx, id, res.Bucket.Key, "shared/file2.jpg")
+	require.NoError(t, err)
+	e = nextListenEvent(t, events)
+	assert.Equal(t, buckets.EventPathRemoved, e.Type)
+	assert.Equal(t, "shared/file2.jpg", e.Path)
+	e = nextListenEvent(t, userEvents)
+	assert.Equal(t, buckets.EventPathRemoved, e.Type)
+
+	err = c.PushPathAccessRoles(ctx, id, res.Bucket.Key, "shared", map[did.DID]collection.Role{
+		user: collection.WriterRole,
+	})
+	require.NoError(t, err)
+	e = nextListenEvent(t, events)
+	assert.Equal(t, buckets.EventRolesChanged, e.Type)
+	assert.Equal(t, "shared", e.Path)
+	assert.Equal(t, owner, e.Identity)
+	e = nextListenEvent(t, userEvents)
+	assert.Equal(t, buckets.EventRolesChanged, e.Type)
+
+	_, err = c.RotateKeys(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	e = nextListenEvent(t, events)
+	assert.Equal(t, buckets.EventRootReplaced, e.Type)
+	e = nextListenEvent(t, userEvents)
+	assert.Equal(t, buckets.EventRootReplaced, e.Type)
+
+	// Removing the bucket ends the stream
+	err = c.Remove(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	e = nextListenEvent(t, events)
+	assert.Equal(t, buckets.EventBucketRemoved, e.Type)
+	assert.Nil(t, e.Bucket)
+	select {
+	case le, ok := <-events:
+		assert.False(t, ok, "unexpected event: %v", le)
+	case <-time.After(time.Second * 10):
+		t.Fatal("listener was not closed")
+	}
+}
+
+func TestClient_ListenMove(t *testing.T) {
+	c := newClient(t)
+	ctx, _ := newIdentityCtx(t, c)
+
+	res, err := c.Create(ctx)
+	require.NoError(t, err)
+	id := thread.MustDecode(res.Bucket.Thread)
+	q, err := c.PushPaths(ctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("dir1/file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+
+	lctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events, err := c.Listen(lctx, id, res.Bucket.Key)
+	require.NoError(t, err)
+
+	err = c.MovePath(ctx, id, res.Bucket.Key, "dir1/file1.jpg", "file2.jpg")
+	require.NoError(t, err)
+	e := nextListenEvent(t, events)
+	assert.Equal(t, buckets.EventPathMoved, e.Type)
+	assert.Equal(t, "dir1/file1.jpg", e.Path)
+	assert.Equal(t, "file2.jpg", e.ToPath)
+
+	// Batches are reported by operation, in order
+	_, err = c.Batch(ctx, id, res.Bucket.Key, []buckets.BatchOp{
+		{Type: buckets.BatchPutPath, Path: "file3.txt", Data: []byte("three")},
+		{Type: buckets.BatchPutPath, Path: "file2.jpg", Data: []byte("two")},
+		{Type: buckets.BatchMovePath, Path: "file3.txt", ToPath: "dir2/file3.txt"},
+		{Type: buckets.BatchRemovePath, Path: "file2.jpg"},
+	})
+	require.NoError(t, err)
+	for _, x := range []struct {
+		typ    buckets.EventType
+		path   string
+		toPath string
+	}{
+		{buckets.EventPathAdded, "file3.txt", ""},
+		{buckets.EventPathModified, "file2.jpg", ""},
+		{buckets.EventPathMoved, "file3.txt", "dir2/file3.txt"},
+		{buckets.EventPathRemoved, "file2.jpg", ""},
+	} {
+		e := nextListenEvent(t, events)
+		assert.Equal(t, x.typ, e.Type)
+		assert.Equal(t, x.path, e.Path)
+		assert.Equal(t, x.toPath, e.ToPath)
+	}
+}
+
+func nextListenEvent(t *testing.T, events <-chan client.ListenEvent) *buckets.Event {
+	select {
+	case le, ok := <-events:
+		require.True(t, ok, "listener was closed")
+		require.NoError(t, le.Err)
+		return le.Event
+	case <-time.After(time.Second * 10):
+		t.Fatal("timed out waiting for event")
+		return nil
+	}
+}
+
+func TestClient_GetUsage(t *testing.T) {
+	c := newClient(t)
+	ctx, id := newIdentityCtx(t, c)
+
+	usage, err := c.GetUsage(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), usage.StorageUsed)
+	assert.Equal(t, quota.Unlimited, usage.StorageAvailable)
+
+	res, err := c.Create(ctx)
+	require.NoError(t, err)
+	q, err := c.PushPaths(ctx, thread.MustDecode(res.Bucket.Thread), res.Bucket.Key)
+	require.NoError(t, err)
+	err = q.AddFile("file1.jpg", "testdata/file1.jpg")
+	require.NoError(t, err)
+	for q.Next() {
+		require.NoError(t, q.Err())
+	}
+	q.Close()
+
+	usage, err = c.GetUsage(ctx, "")
+	require.NoError(t, err)
+	assert.Greater(t, usage.StorageUsed, int64(0))
+	user, err := id.GetPublic().DID()
+	require.NoError(t, err)
+	assert.Equal(t, user, usage.Identity)
+
+	t.Run("writer", func(t *testing.T) {
+		ctx2, writerIdentity := newIdentityCtx(t, c)
+		writer, err := writerIdentity.GetPublic().DID()
+		require.NoError(t, err)
+		err = c.PushPathAccessRoles(ctx, thread.MustDecode(res.Bucket.Thread), res.Bucket.Key, "", map[did.DID]collection.Role{
+			writer: collection.WriterRole,
+		})
+		require.NoError(t, err)
+
+		// Storage pinned by writers is charged to the bucket owner
+		q, err := c.PushPaths(ctx2, thread.MustDecode(res.Bucket.Thread), res.Bucket.Key)
+		require.NoError(t, err)
+		err = q.AddFile("file2.jpg", "testdata/file2.jpg")
+		require.NoError(t, err)
+		for q.Next() {
+			require.NoError(t, q.Err())
+		}
+		q.Close()
+
+		ownerUsage, err := c.GetUsage(ctx, "")
+		require.NoError(t, err)
+		assert.Greater(t, ownerUsage.StorageUsed, usage.StorageUsed)
+		writerUsage, err := c.GetUsage(ctx2, "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), writerUsage.StorageUsed)
+	})
+
+	t.Run("non-admin", func(t *testing.T) {
+		ctx2, _ := newIdentityCtx(t, c)
+		_, err := c.GetUsage(ctx2, usage.Identity)
+		require.Error(t, err)
+		_, err = c.SetUsage(ctx2, usage.Identity, 0, 1024)
+		require.Error(t, err)
+	})
 }
 
-func newClient(t *testing.T) *client.Client {
-	listenAddr, _ := apitest.NewService(t)
+func newClient(t *testing.T, opts ...buckets.BucketsOption) *client.Client {
+	listenAddr, _ := apitest.NewService(t, opts...)
 	c, err := client.NewClient(listenAddr, common.GetClientRPCOpts(listenAddr)...)
 	require.NoError(t, err)
 	t.Cleanup(func() {
@@ -12,6 +12,8 @@ import (
 	"github.com/textileio/go-buckets"
 	"github.com/textileio/go-buckets/api"
 	pb "github.com/textileio/go-buckets/api/pb/buckets"
+	"github.com/textileio/go-buckets/quota"
+	"github.com/textileio/go-buckets/share"
 	"github.com/textileio/go-threads/core/did"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -32,14 +34,63 @@ func GetClientRPCOpts(target string) (opts []grpc.DialOption) {
 	return opts
 }
 
-func GetServerAndProxy(lib *buckets.Buckets, listenAddr, listenAddrProxy string) (*grpc.Server, *http.Server, error) {
-	server := grpc.NewServer()
+// Option configures the API server.
+type Option func(*options)
+
+type options struct {
+	ledger *quota.Ledger
+	admins []did.DID
+	shares *share.Store
+}
+
+// WithQuota enables storage usage accounting and limits with ledger.
+// Admins may get and set usage for any identity.
+func WithQuota(ledger *quota.Ledger, admins ...did.DID) Option {
+	return func(o *options) {
+		o.ledger = ledger
+		o.admins = admins
+	}
+}
+
+// WithShares enables the share link API with store.
+func WithShares(store *share.Store) Option {
+	return func(o *options) {
+		o.shares = store
+	}
+}
+
+func GetServerAndProxy(
+	lib *buckets.Buckets,
+	listenAddr, listenAddrProxy string,
+	opts ...Option,
+) (*grpc.Server, *http.Server, error) {
+	args := &options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var (
+		sopts   []grpc.ServerOption
+		svcopts []api.ServiceOption
+	)
+	if args.ledger != nil {
+		sopts = append(
+			sopts,
+			grpc.UnaryInterceptor(args.ledger.UnaryServerInterceptor()),
+			grpc.StreamInterceptor(args.ledger.StreamServerInterceptor()),
+		)
+		svcopts = append(svcopts, api.WithLedger(args.ledger, args.admins...))
+	}
+	if args.shares != nil {
+		svcopts = append(svcopts, api.WithShares(args.shares))
+	}
+
+	server := grpc.NewServer(sopts...)
 	listener, err := gnet.Listen("tcp", listenAddr)
 	if err != nil {
 		return nil, nil, err
 	}
 	go func() {
-		pb.RegisterAPIServiceServer(server, api.NewService(lib))
+		pb.RegisterAPIServiceServer(server, api.NewService(lib, svcopts...))
 		if err := server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
 			log.Errorf("server error: %v", err)
 		}
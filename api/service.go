@@ -1,16 +1,21 @@
 package api
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	c "github.com/ipfs/go-cid"
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/go-buckets"
 	"github.com/textileio/go-buckets/api/cast"
 	pb "github.com/textileio/go-buckets/api/pb/buckets"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/quota"
+	"github.com/textileio/go-buckets/share"
 	"github.com/textileio/go-buckets/util"
 	"github.com/textileio/go-threads/core/did"
 	core "github.com/textileio/go-threads/core/thread"
@@ -19,18 +24,54 @@ import (
 // chunkSize for get file requests.
 const chunkSize = 1024 * 32 // 32 KiB
 
+var (
+	errPermissionDenied = errors.New("permission denied")
+	errUsageDisabled    = errors.New("usage accounting is not enabled")
+	errSharesDisabled   = errors.New("share links are not enabled")
+)
+
 // Service is a gRPC service for buckets.
 type Service struct {
-	lib *buckets.Buckets
+	lib    *buckets.Buckets
+	ledger *quota.Ledger
+	admins map[did.DID]struct{}
+	shares *share.Store
 }
 
 var _ pb.APIServiceServer = (*Service)(nil)
 
+// ServiceOption configures a Service.
+type ServiceOption func(*Service)
+
+// WithLedger enables the storage usage API.
+// Admins may get and set usage for any identity.
+// Other identities may only get their own usage.
+func WithLedger(ledger *quota.Ledger, admins ...did.DID) ServiceOption {
+	return func(s *Service) {
+		s.ledger = ledger
+		for _, a := range admins {
+			s.admins[a] = struct{}{}
+		}
+	}
+}
+
+// WithShares enables the share link API.
+func WithShares(store *share.Store) ServiceOption {
+	return func(s *Service) {
+		s.shares = store
+	}
+}
+
 // NewService returns a new buckets gRPC service.
-func NewService(lib *buckets.Buckets) *Service {
-	return &Service{
-		lib: lib,
+func NewService(lib *buckets.Buckets, opts ...ServiceOption) *Service {
+	s := &Service{
+		lib:    lib,
+		admins: make(map[did.DID]struct{}),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *Service) Create(ctx context.Context, req *pb.CreateRequest) (*pb.CreateResponse, error) {
@@ -147,7 +188,7 @@ func (s *Service) ListPath(ctx context.Context, req *pb.ListPathRequest) (*pb.Li
 		return nil, err
 	}
 
-	item, bucket, err := s.lib.ListPath(ctx, thread, req.Key, req.Path, identity)
+	item, bucket, cursor, err := s.lib.ListPath(ctx, thread, req.Key, req.Path, identity, listPathOptions(req)...)
 	if err != nil {
 		return nil, err
 	}
@@ -159,9 +200,40 @@ func (s *Service) ListPath(ctx context.Context, req *pb.ListPathRequest) (*pb.Li
 		Item:   cast.ItemToPb(item),
 		Bucket: cast.BucketToPb(bucket),
 		Links:  cast.LinksToPb(links),
+		Cursor: cursor,
 	}, nil
 }
 
+func (s *Service) ListPathStream(req *pb.ListPathRequest, server pb.APIService_ListPathStreamServer) error {
+	thread, identity, err := getThreadAndIdentity(server.Context(), req.Thread)
+	if err != nil {
+		return err
+	}
+
+	return s.lib.WalkPath(
+		server.Context(),
+		thread,
+		req.Key,
+		req.Path,
+		identity,
+		func(rel string, item *buckets.PathItem) error {
+			return server.Send(&pb.ListPathStreamResponse{
+				Item:   cast.ItemToPb(item),
+				Cursor: rel,
+			})
+		},
+		listPathOptions(req)...,
+	)
+}
+
+func listPathOptions(req *pb.ListPathRequest) []buckets.ListPathOption {
+	return []buckets.ListPathOption{
+		buckets.WithListDepth(int(req.Depth)),
+		buckets.WithListLimit(int(req.Limit)),
+		buckets.WithListCursor(req.Cursor),
+	}
+}
+
 func (s *Service) ListIpfsPath(ctx context.Context, req *pb.ListIpfsPathRequest) (*pb.ListIpfsPathResponse, error) {
 	item, err := s.lib.ListIPFSPath(ctx, req.Path)
 	if err != nil {
@@ -172,6 +244,26 @@ func (s *Service) ListIpfsPath(ctx context.Context, req *pb.ListIpfsPathRequest)
 	}, nil
 }
 
+func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	items, cursor, err := s.lib.Search(ctx, thread, req.Key, cast.SearchQueryFromPb(req), identity)
+	if err != nil {
+		return nil, err
+	}
+	pitems := make([]*pb.PathItem, len(items))
+	for i, item := range items {
+		pitems[i] = cast.ItemToPb(&item)
+	}
+	return &pb.SearchResponse{
+		Items:  pitems,
+		Cursor: cursor,
+	}, nil
+}
+
 func (s *Service) PushPaths(server pb.APIService_PushPathsServer) error {
 	identity, err := did.NewTokenFromMD(server.Context())
 	if err != nil {
@@ -183,9 +275,10 @@ func (s *Service) PushPaths(server pb.APIService_PushPathsServer) error {
 		return fmt.Errorf("on receive: %v", err)
 	}
 	var (
-		thread core.ID
-		key    string
-		root   path.Resolved
+		thread  core.ID
+		key     string
+		root    path.Resolved
+		session string
 	)
 	switch payload := req.Payload.(type) {
 	case *pb.PushPathsRequest_Header_:
@@ -200,11 +293,16 @@ func (s *Service) PushPaths(server pb.APIService_PushPathsServer) error {
 				return fmt.Errorf("resolving root path: %v", err)
 			}
 		}
+		session = payload.Header.SessionId
 	default:
 		return fmt.Errorf("push bucket path header is required")
 	}
 
-	in, out, errs := s.lib.PushPaths(server.Context(), thread, key, root, identity)
+	var opts []buckets.PushPathsOption
+	if len(session) != 0 {
+		opts = append(opts, buckets.WithUploadSession(session))
+	}
+	in, out, errs := s.lib.PushPaths(server.Context(), thread, key, root, identity, opts...)
 	if len(errs) != 0 {
 		return <-errs
 	}
@@ -222,8 +320,9 @@ func (s *Service) PushPaths(server pb.APIService_PushPathsServer) error {
 			switch payload := req.Payload.(type) {
 			case *pb.PushPathsRequest_Chunk_:
 				in <- buckets.PushPathsChunk{
-					Path: payload.Chunk.Path,
-					Data: payload.Chunk.Data,
+					Path:   payload.Chunk.Path,
+					Data:   payload.Chunk.Data,
+					Offset: payload.Chunk.Offset,
 				}
 			default:
 				errCh <- fmt.Errorf("invalid request")
@@ -252,13 +351,56 @@ func (s *Service) PushPaths(server pb.APIService_PushPathsServer) error {
 	}
 }
 
+func (s *Service) NewUploadSession(
+	ctx context.Context,
+	req *pb.NewUploadSessionRequest,
+) (*pb.NewUploadSessionResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.lib.NewUploadSession(ctx, thread, req.Key, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.NewUploadSessionResponse{
+		Session: cast.UploadSessionToPb(session),
+	}, nil
+}
+
+func (s *Service) GetUploadSession(
+	ctx context.Context,
+	req *pb.GetUploadSessionRequest,
+) (*pb.GetUploadSessionResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.lib.GetUploadSession(ctx, thread, req.Key, req.Id, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUploadSessionResponse{
+		Session: cast.UploadSessionToPb(session),
+	}, nil
+}
+
 func (s *Service) PullPath(req *pb.PullPathRequest, server pb.APIService_PullPathServer) error {
 	thread, identity, err := getThreadAndIdentity(server.Context(), req.Thread)
 	if err != nil {
 		return err
 	}
 
-	reader, err := s.lib.PullPath(server.Context(), thread, req.Key, req.Path, identity)
+	reader, err := s.lib.PullPath(
+		server.Context(),
+		thread,
+		req.Key,
+		req.Path,
+		identity,
+		buckets.WithRange(req.Offset, req.Length),
+	)
 	if err != nil {
 		return err
 	}
@@ -282,6 +424,27 @@ func (s *Service) PullPath(req *pb.PullPathRequest, server pb.APIService_PullPat
 	}
 }
 
+func (s *Service) PullPathArchive(req *pb.PullPathArchiveRequest, server pb.APIService_PullPathArchiveServer) error {
+	thread, identity, err := getThreadAndIdentity(server.Context(), req.Thread)
+	if err != nil {
+		return err
+	}
+	format, err := cast.ArchiveFormatFromPb(req.Format)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(chunkWriter(func(chunk []byte) error {
+		return server.Send(&pb.PullPathArchiveResponse{
+			Chunk: chunk,
+		})
+	}), chunkSize)
+	if err := s.lib.PullPathArchive(server.Context(), thread, req.Key, req.Path, identity, w, format); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
 func (s *Service) PullIpfsPath(req *pb.PullIpfsPathRequest, server pb.APIService_PullIpfsPathServer) error {
 	reader, err := s.lib.PullIPFSPath(server.Context(), req.Path)
 	if err != nil {
@@ -316,8 +479,15 @@ func (s *Service) SetPath(ctx context.Context, req *pb.SetPathRequest) (*pb.SetP
 	if err != nil {
 		return nil, fmt.Errorf("decoding cid: %v", err)
 	}
+	var root path.Resolved
+	if len(req.Root) != 0 {
+		root, err = util.NewResolvedPath(req.Root)
+		if err != nil {
+			return nil, fmt.Errorf("resolving root path: %v", err)
+		}
+	}
 
-	pinned, bucket, err := s.lib.SetPath(ctx, thread, req.Key, req.Path, cid, identity)
+	pinned, bucket, err := s.lib.SetPath(ctx, thread, req.Key, req.Path, cid, root, identity)
 	if err != nil {
 		return nil, err
 	}
@@ -333,8 +503,15 @@ func (s *Service) MovePath(ctx context.Context, req *pb.MovePathRequest) (res *p
 	if err != nil {
 		return nil, err
 	}
+	var root path.Resolved
+	if len(req.Root) != 0 {
+		root, err = util.NewResolvedPath(req.Root)
+		if err != nil {
+			return nil, fmt.Errorf("resolving root path: %v", err)
+		}
+	}
 
-	pinned, bucket, err := s.lib.MovePath(ctx, thread, req.Key, req.FromPath, req.ToPath, identity)
+	pinned, bucket, err := s.lib.MovePath(ctx, thread, req.Key, req.FromPath, req.ToPath, root, identity)
 	if err != nil {
 		return nil, err
 	}
@@ -376,8 +553,15 @@ func (s *Service) PushPathAccessRoles(
 		return nil, err
 	}
 	roles := cast.RolesFromPb(req.Roles)
+	var root path.Resolved
+	if len(req.Root) != 0 {
+		root, err = util.NewResolvedPath(req.Root)
+		if err != nil {
+			return nil, fmt.Errorf("resolving root path: %v", err)
+		}
+	}
 
-	pinned, bucket, err := s.lib.PushPathAccessRoles(ctx, thread, req.Key, req.Path, roles, identity)
+	pinned, bucket, err := s.lib.PushPathAccessRoles(ctx, thread, req.Key, req.Path, roles, root, identity)
 	if err != nil {
 		return nil, err
 	}
@@ -405,6 +589,638 @@ func (s *Service) PullPathAccessRoles(
 	}, nil
 }
 
+func (s *Service) PushPathAttributes(
+	ctx context.Context,
+	req *pb.PushPathAttributesRequest,
+) (res *pb.PushPathAttributesResponse, err error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+	var root path.Resolved
+	if len(req.Root) != 0 {
+		root, err = util.NewResolvedPath(req.Root)
+		if err != nil {
+			return nil, fmt.Errorf("resolving root path: %v", err)
+		}
+	}
+
+	pinned, bucket, err := s.lib.PushPathAttributes(ctx, thread, req.Key, req.Path, req.Attributes, root, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PushPathAttributesResponse{
+		Bucket: cast.BucketToPb(bucket),
+		Pinned: pinned,
+	}, nil
+}
+
+func (s *Service) PullPathAttributes(
+	ctx context.Context,
+	req *pb.PullPathAttributesRequest,
+) (*pb.PullPathAttributesResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := s.lib.PullPathAttributes(ctx, thread, req.Key, req.Path, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PullPathAttributesResponse{
+		Attributes: attrs,
+	}, nil
+}
+
+func (s *Service) EffectiveAccess(
+	ctx context.Context,
+	req *pb.EffectiveAccessRequest,
+) (*pb.EffectiveAccessResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+	id := did.DID(req.Identity)
+	if len(id) == 0 {
+		id, err = s.lib.ValidateIdentity(ctx, identity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	report, err := s.lib.EffectiveAccess(ctx, thread, req.Key, req.Path, id, identity)
+	if err != nil {
+		return nil, err
+	}
+	return cast.AccessReportToPb(report), nil
+}
+
+func (s *Service) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*pb.CreateGroupResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.lib.CreateGroup(ctx, thread, req.Name, cast.MembersFromPb(req.Members), identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateGroupResponse{
+		Group: cast.GroupToPb(group),
+	}, nil
+}
+
+func (s *Service) GetGroup(ctx context.Context, req *pb.GetGroupRequest) (*pb.GetGroupResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.lib.GetGroup(ctx, thread, req.Name, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetGroupResponse{
+		Group: cast.GroupToPb(group),
+	}, nil
+}
+
+func (s *Service) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.lib.ListGroups(ctx, thread, identity)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*pb.Group, len(list))
+	for i, g := range list {
+		groups[i] = cast.GroupToPb(&g)
+	}
+	return &pb.ListGroupsResponse{
+		Groups: groups,
+	}, nil
+}
+
+func (s *Service) AddGroupMembers(
+	ctx context.Context,
+	req *pb.AddGroupMembersRequest,
+) (*pb.AddGroupMembersResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.lib.AddGroupMembers(ctx, thread, req.Name, cast.MembersFromPb(req.Members), identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddGroupMembersResponse{
+		Group: cast.GroupToPb(group),
+	}, nil
+}
+
+func (s *Service) RemoveGroupMembers(
+	ctx context.Context,
+	req *pb.RemoveGroupMembersRequest,
+) (*pb.RemoveGroupMembersResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.lib.RemoveGroupMembers(ctx, thread, req.Name, cast.MembersFromPb(req.Members), identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RemoveGroupMembersResponse{
+		Group: cast.GroupToPb(group),
+	}, nil
+}
+
+func (s *Service) DeleteGroup(ctx context.Context, req *pb.DeleteGroupRequest) (*pb.DeleteGroupResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.lib.DeleteGroup(ctx, thread, req.Name, identity); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteGroupResponse{}, nil
+}
+
+func (s *Service) ListVersions(
+	ctx context.Context,
+	req *pb.ListVersionsRequest,
+) (*pb.ListVersionsResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.lib.ListVersions(ctx, thread, req.Key, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListVersionsResponse{
+		Versions: cast.VersionsToPb(versions),
+	}, nil
+}
+
+func (s *Service) RestoreVersion(
+	ctx context.Context,
+	req *pb.RestoreVersionRequest,
+) (*pb.RestoreVersionResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+	var root path.Resolved
+	if len(req.Root) != 0 {
+		root, err = util.NewResolvedPath(req.Root)
+		if err != nil {
+			return nil, fmt.Errorf("resolving root path: %v", err)
+		}
+	}
+
+	pinned, bucket, err := s.lib.RestoreVersion(ctx, thread, req.Key, req.Version, root, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreVersionResponse{
+		Bucket: cast.BucketToPb(bucket),
+		Pinned: pinned,
+	}, nil
+}
+
+func (s *Service) RotateKeys(ctx context.Context, req *pb.RotateKeysRequest) (*pb.RotateKeysResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+	opts := []buckets.RotateKeysOption{buckets.WithRotateFileKeys(req.FileKeys)}
+	if len(req.Root) != 0 {
+		root, err := util.NewResolvedPath(req.Root)
+		if err != nil {
+			return nil, fmt.Errorf("resolving root path: %v", err)
+		}
+		opts = append(opts, buckets.WithRotateRoot(root))
+	}
+
+	pinned, bucket, err := s.lib.RotateKeys(ctx, thread, req.Key, identity, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RotateKeysResponse{
+		Bucket: cast.BucketToPb(bucket),
+		Pinned: pinned,
+	}, nil
+}
+
+func (s *Service) SetPrivacy(ctx context.Context, req *pb.SetPrivacyRequest) (*pb.SetPrivacyResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+	var root path.Resolved
+	if len(req.Root) != 0 {
+		root, err = util.NewResolvedPath(req.Root)
+		if err != nil {
+			return nil, fmt.Errorf("resolving root path: %v", err)
+		}
+	}
+
+	pinned, bucket, err := s.lib.SetPrivacy(ctx, thread, req.Key, req.Private, root, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetPrivacyResponse{
+		Bucket: cast.BucketToPb(bucket),
+		Pinned: pinned,
+	}, nil
+}
+
+func (s *Service) Fork(ctx context.Context, req *pb.ForkRequest) (*pb.ForkResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+	var toThread core.ID
+	if len(req.ToThread) != 0 {
+		toThread, err = core.Decode(req.ToThread)
+		if err != nil {
+			return nil, fmt.Errorf("decoding thread: %v", err)
+		}
+	}
+
+	bucket, pinned, err := s.lib.Fork(
+		ctx,
+		thread,
+		req.Key,
+		toThread,
+		identity,
+		buckets.WithForkName(req.Name),
+		buckets.WithForkPrivate(req.Private),
+		buckets.WithForkRoles(req.Roles),
+	)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.lib.GetLinksForBucket(ctx, bucket, "", identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ForkResponse{
+		Bucket: cast.BucketToPb(bucket),
+		Links:  cast.LinksToPb(links),
+		Pinned: pinned,
+	}, nil
+}
+
+func (s *Service) Export(req *pb.ExportRequest, server pb.APIService_ExportServer) error {
+	thread, identity, err := getThreadAndIdentity(server.Context(), req.Thread)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(chunkWriter(func(chunk []byte) error {
+		return server.Send(&pb.ExportResponse{
+			Chunk: chunk,
+		})
+	}), chunkSize)
+	if err := s.lib.Export(server.Context(), thread, req.Key, identity, w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// chunkWriter sends written bytes as stream chunks of at most chunkSize.
+type chunkWriter func(chunk []byte) error
+
+func (w chunkWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		size := len(p)
+		if size > chunkSize {
+			size = chunkSize
+		}
+		if err := w(p[:size]); err != nil {
+			return n, err
+		}
+		n += size
+		p = p[size:]
+	}
+	return n, nil
+}
+
+func (s *Service) Import(server pb.APIService_ImportServer) error {
+	identity, err := did.NewTokenFromMD(server.Context())
+	if err != nil {
+		return fmt.Errorf("getting identity token: %v", err)
+	}
+
+	req, err := server.Recv()
+	if err != nil {
+		return fmt.Errorf("on receive: %v", err)
+	}
+	var (
+		thread core.ID
+		opts   []buckets.ImportOption
+	)
+	switch payload := req.Payload.(type) {
+	case *pb.ImportRequest_Header_:
+		if len(payload.Header.Thread) != 0 {
+			thread, err = core.Decode(payload.Header.Thread)
+			if err != nil {
+				return fmt.Errorf("decoding thread: %v", err)
+			}
+		}
+		opts = append(
+			opts,
+			buckets.WithImportName(payload.Header.Name),
+			buckets.WithImportPrivate(payload.Header.Private),
+			buckets.WithImportRegenerateKeys(payload.Header.RegenerateKeys),
+		)
+	default:
+		return fmt.Errorf("import header is required")
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		for {
+			req, err := server.Recv()
+			if err == io.EOF {
+				_ = writer.Close()
+				return
+			} else if err != nil {
+				_ = writer.CloseWithError(fmt.Errorf("on receive: %v", err))
+				return
+			}
+			switch payload := req.Payload.(type) {
+			case *pb.ImportRequest_Chunk:
+				if _, err := writer.Write(payload.Chunk); err != nil {
+					return
+				}
+			default:
+				_ = writer.CloseWithError(fmt.Errorf("invalid request"))
+				return
+			}
+		}
+	}()
+
+	bucket, pinned, err := s.lib.Import(server.Context(), thread, identity, reader, opts...)
+	_ = reader.CloseWithError(err)
+	if err != nil {
+		return err
+	}
+	links, err := s.lib.GetLinksForBucket(server.Context(), bucket, "", identity)
+	if err != nil {
+		return err
+	}
+	return server.SendAndClose(&pb.ImportResponse{
+		Bucket: cast.BucketToPb(bucket),
+		Links:  cast.LinksToPb(links),
+		Pinned: pinned,
+	})
+}
+
+func (s *Service) Verify(ctx context.Context, req *pb.VerifyRequest) (*pb.VerifyResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.lib.Verify(ctx, thread, req.Key, identity)
+	if err != nil {
+		return nil, err
+	}
+	return cast.VerifyReportToPb(report), nil
+}
+
+func (s *Service) CreateShareLink(
+	ctx context.Context,
+	req *pb.CreateShareLinkRequest,
+) (*pb.CreateShareLinkResponse, error) {
+	if s.shares == nil {
+		return nil, errSharesDisabled
+	}
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+	issuer, err := s.lib.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	// Links are read-only, so the issuer must be able to read the path
+	if _, _, _, err := s.lib.ListPath(ctx, thread, req.Key, req.Path, identity); err != nil {
+		return nil, err
+	}
+
+	link, err := s.shares.Create(
+		thread,
+		req.Key,
+		req.Path,
+		issuer,
+		collection.ReaderRole,
+		time.Duration(req.Ttl),
+		int(req.MaxDownloads),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateShareLinkResponse{
+		Link: cast.ShareLinkToPb(link, shareLinkURL(link)),
+	}, nil
+}
+
+func (s *Service) ListShareLinks(
+	ctx context.Context,
+	req *pb.ListShareLinksRequest,
+) (*pb.ListShareLinksResponse, error) {
+	if s.shares == nil {
+		return nil, errSharesDisabled
+	}
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+	issuer, err := s.lib.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.shares.List(thread, req.Key, issuer)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*pb.ShareLink, len(links))
+	for i, l := range links {
+		res[i] = cast.ShareLinkToPb(l, shareLinkURL(l))
+	}
+	return &pb.ListShareLinksResponse{
+		Links: res,
+	}, nil
+}
+
+func (s *Service) RevokeShareLink(
+	ctx context.Context,
+	req *pb.RevokeShareLinkRequest,
+) (*pb.RevokeShareLinkResponse, error) {
+	if s.shares == nil {
+		return nil, errSharesDisabled
+	}
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+	issuer, err := s.lib.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := s.shares.Get(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if link.Thread != thread || link.Key != req.Key {
+		return nil, share.ErrNotFound
+	}
+	if err := s.shares.Revoke(req.Id, issuer); err != nil {
+		return nil, err
+	}
+	return &pb.RevokeShareLinkResponse{}, nil
+}
+
+// shareLinkURL returns the gateway URL for a share link.
+func shareLinkURL(link *share.Link) string {
+	u := fmt.Sprintf("%s/thread/%s/%s/%s", buckets.GatewayURL, link.Thread, collection.Name, link.Key)
+	if link.Path != "" {
+		u += "/" + link.Path
+	}
+	return u + "?share=" + link.ID
+}
+
+func (s *Service) Batch(ctx context.Context, req *pb.BatchRequest) (*pb.BatchResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+	ops, err := cast.BatchOpsFromPb(req.Ops)
+	if err != nil {
+		return nil, err
+	}
+	var root path.Resolved
+	if len(req.Root) != 0 {
+		root, err = util.NewResolvedPath(req.Root)
+		if err != nil {
+			return nil, fmt.Errorf("resolving root path: %v", err)
+		}
+	}
+
+	pinned, bucket, err := s.lib.Batch(ctx, thread, req.Key, ops, root, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchResponse{
+		Bucket: cast.BucketToPb(bucket),
+		Pinned: pinned,
+	}, nil
+}
+
+func (s *Service) ListAuditRecords(
+	ctx context.Context,
+	req *pb.ListAuditRecordsRequest,
+) (*pb.ListAuditRecordsResponse, error) {
+	thread, identity, err := getThreadAndIdentity(ctx, req.Thread)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.lib.AuditRecords(ctx, thread, cast.AuditQueryFromPb(req), identity)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListAuditRecordsResponse{
+		Records: cast.AuditRecordsToPb(records),
+	}, nil
+}
+
+func (s *Service) Listen(req *pb.ListenRequest, server pb.APIService_ListenServer) error {
+	thread, identity, err := getThreadAndIdentity(server.Context(), req.Thread)
+	if err != nil {
+		return err
+	}
+
+	events, errs, err := s.lib.Listen(server.Context(), thread, req.Key, identity)
+	if err != nil {
+		return err
+	}
+	for e := range events {
+		if err := server.Send(cast.EventToPb(e)); err != nil {
+			return err
+		}
+	}
+	return <-errs
+}
+
+func (s *Service) GetUsage(ctx context.Context, req *pb.GetUsageRequest) (*pb.GetUsageResponse, error) {
+	caller, err := s.getUsageCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := did.DID(req.Identity)
+	if len(id) == 0 {
+		id = caller
+	} else if id != caller && !s.isAdmin(caller) {
+		return nil, errPermissionDenied
+	}
+	usage, err := s.ledger.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUsageResponse{
+		Usage: cast.UsageToPb(usage),
+	}, nil
+}
+
+func (s *Service) SetUsage(ctx context.Context, req *pb.SetUsageRequest) (*pb.SetUsageResponse, error) {
+	caller, err := s.getUsageCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !s.isAdmin(caller) {
+		return nil, errPermissionDenied
+	}
+	usage, err := s.ledger.Set(did.DID(req.Identity), req.StorageUsed, req.StorageLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetUsageResponse{
+		Usage: cast.UsageToPb(usage),
+	}, nil
+}
+
+// getUsageCaller returns the DID of the caller if the usage API is enabled.
+func (s *Service) getUsageCaller(ctx context.Context) (did.DID, error) {
+	if s.ledger == nil {
+		return "", errUsageDisabled
+	}
+	identity, err := did.NewTokenFromMD(ctx)
+	if err != nil {
+		return "", fmt.Errorf("getting identity token: %v", err)
+	}
+	return s.lib.ValidateIdentity(ctx, identity)
+}
+
+func (s *Service) isAdmin(id did.DID) bool {
+	_, ok := s.admins[id]
+	return ok
+}
+
 func getThreadAndIdentity(ctx context.Context, threadStr string) (thread core.ID, identity did.Token, err error) {
 	if len(threadStr) != 0 {
 		thread, err = core.Decode(threadStr)
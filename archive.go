@@ -0,0 +1,247 @@
+package buckets
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	gopath "path"
+	"strings"
+	"time"
+
+	c "github.com/ipfs/go-cid"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+// ArchiveFormat is a folder archive format.
+type ArchiveFormat int
+
+const (
+	// ArchiveTar is an uncompressed tar archive.
+	ArchiveTar ArchiveFormat = iota
+	// ArchiveTarGz is a gzip compressed tar archive.
+	ArchiveTarGz
+	// ArchiveZip is a zip archive.
+	ArchiveZip
+)
+
+// NewArchiveFormatFromString returns the archive format associated with the given string.
+func NewArchiveFormatFromString(s string) (ArchiveFormat, error) {
+	switch strings.ToLower(s) {
+	case "tar":
+		return ArchiveTar, nil
+	case "tar.gz", "tgz":
+		return ArchiveTarGz, nil
+	case "zip":
+		return ArchiveZip, nil
+	default:
+		return ArchiveTar, fmt.Errorf("invalid archive format: %s", s)
+	}
+}
+
+// String returns the string representation of the format, which is also its file extension.
+func (f ArchiveFormat) String() string {
+	switch f {
+	case ArchiveTar:
+		return "tar"
+	case ArchiveTarGz:
+		return "tar.gz"
+	case ArchiveZip:
+		return "zip"
+	default:
+		return "invalid"
+	}
+}
+
+// ContentType returns the MIME type of the format.
+func (f ArchiveFormat) ContentType() string {
+	switch f {
+	case ArchiveTarGz:
+		return "application/gzip"
+	case ArchiveZip:
+		return "application/zip"
+	default:
+		return "application/x-tar"
+	}
+}
+
+// PullPathArchive writes the folder at path and all of its descendants to w as an archive.
+// Private bucket files are decrypted as they are written.
+// Archive entry names are relative to path. If path is a file, the archive only contains the file.
+// Bucket seed files are not included.
+func (b *Buckets) PullPathArchive(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	identity did.Token,
+	w io.Writer,
+	format ArchiveFormat,
+) error {
+	pth = trimSlash(pth)
+	instance, bpth, err := b.getBucketAndPath(ctx, thread, key, pth, identity)
+	if err != nil {
+		return err
+	}
+	linkKey := instance.GetLinkEncryptionKey()
+	n, err := dag.GetNodeAtPath(ctx, b.ipfs, bpth, linkKey)
+	if err != nil {
+		return err
+	}
+
+	aw, err := newArchiveWriter(w, format)
+	if err != nil {
+		return err
+	}
+	prefix := pth + "/"
+	if pth == "" {
+		prefix = ""
+	}
+	wk := &walker{
+		b:        b,
+		instance: instance,
+		key:      linkKey,
+		depth:    ListDepthUnlimited,
+	}
+	if err := wk.walk(ctx, n, bpth.String(), pth, 0, func(rel string, item *PathItem, depth int, _ bool) error {
+		name := strings.TrimPrefix(rel, prefix)
+		if depth == 0 {
+			if item.IsDir {
+				return nil
+			}
+			name = gopath.Base(rel)
+		}
+		var modTime time.Time
+		if item.Metadata.UpdatedAt != 0 {
+			modTime = time.Unix(0, item.Metadata.UpdatedAt)
+		}
+		if item.IsDir {
+			return aw.writeDir(name, modTime)
+		}
+		return b.archiveFile(ctx, aw, instance.GetFileEncryptionKeyForPath, rel, name, item.Cid, modTime)
+	}); err != nil {
+		return err
+	}
+	if err := aw.Close(); err != nil {
+		return fmt.Errorf("closing archive: %v", err)
+	}
+
+	log.Debugf("pulled %s archive of %s from %s", format, pth, key)
+	return nil
+}
+
+// archiveFile writes the file at rel with cid id to aw as name.
+func (b *Buckets) archiveFile(
+	ctx context.Context,
+	aw archiveWriter,
+	getKey func(string) ([]byte, error),
+	rel, name, id string,
+	modTime time.Time,
+) error {
+	fc, err := c.Decode(id)
+	if err != nil {
+		return fmt.Errorf("decoding cid: %v", err)
+	}
+	fileKey, err := getKey(rel)
+	if err != nil {
+		return err
+	}
+	r, err := b.newPathReader(ctx, path.IpfsPath(fc), fileKey, 0, 0)
+	if err != nil {
+		return fmt.Errorf("reading %s: %v", rel, err)
+	}
+	defer r.Close()
+	return aw.writeFile(name, r.Size(), modTime, r)
+}
+
+// archiveWriter writes folders and files to an archive.
+type archiveWriter interface {
+	writeDir(name string, modTime time.Time) error
+	writeFile(name string, size int64, modTime time.Time, r io.Reader) error
+	io.Closer
+}
+
+func newArchiveWriter(w io.Writer, format ArchiveFormat) (archiveWriter, error) {
+	switch format {
+	case ArchiveTar:
+		return &tarWriter{tw: tar.NewWriter(w)}, nil
+	case ArchiveTarGz:
+		gz := gzip.NewWriter(w)
+		return &tarWriter{tw: tar.NewWriter(gz), gz: gz}, nil
+	case ArchiveZip:
+		return &zipWriter{zw: zip.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("invalid archive format: %d", format)
+	}
+}
+
+type tarWriter struct {
+	tw *tar.Writer
+	gz *gzip.Writer
+}
+
+func (w *tarWriter) writeDir(name string, modTime time.Time) error {
+	return w.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeDir,
+		Name:     name + "/",
+		Mode:     0755,
+		ModTime:  modTime,
+	})
+}
+
+func (w *tarWriter) writeFile(name string, size int64, modTime time.Time, r io.Reader) error {
+	if err := w.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0644,
+		Size:     size,
+		ModTime:  modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := io.Copy(w.tw, r)
+	return err
+}
+
+func (w *tarWriter) Close() error {
+	if err := w.tw.Close(); err != nil {
+		return err
+	}
+	if w.gz != nil {
+		return w.gz.Close()
+	}
+	return nil
+}
+
+type zipWriter struct {
+	zw *zip.Writer
+}
+
+func (w *zipWriter) writeDir(name string, modTime time.Time) error {
+	_, err := w.zw.CreateHeader(&zip.FileHeader{
+		Name:     name + "/",
+		Modified: modTime,
+	})
+	return err
+}
+
+func (w *zipWriter) writeFile(name string, _ int64, modTime time.Time, r io.Reader) error {
+	fw, err := w.zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fw, r)
+	return err
+}
+
+func (w *zipWriter) Close() error {
+	return w.zw.Close()
+}
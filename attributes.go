@@ -0,0 +1,115 @@
+package buckets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets/audit"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+// PushPathAttributes merges attrs into the custom attributes at path.
+// Attributes with an empty value are removed.
+func (b *Buckets) PushPathAttributes(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	attrs map[string]string,
+	root path.Resolved,
+	identity did.Token,
+) (int64, *Bucket, error) {
+	caller, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	lk := b.locks.Get(lock(key))
+	lk.Acquire()
+	defer lk.Release()
+
+	pth, err = parsePath(pth)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	ctx, instance, err := b.getSafe(ctx, thread, key, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+	bpth, err := getBucketPath(instance, pth)
+	if err != nil {
+		return 0, nil, err
+	}
+	if root != nil && root.String() != instance.Path {
+		return 0, nil, ErrNonFastForward
+	}
+	if _, err := dag.GetNodeAtPath(ctx, b.ipfs, bpth, instance.GetLinkEncryptionKey()); err != nil {
+		return 0, nil, fmt.Errorf("could not resolve path: %s", pth)
+	}
+
+	target := instance.Metadata[pth]
+	current := target.Attributes
+	target.Attributes = make(map[string]string)
+	for k, v := range current {
+		target.Attributes[k] = v
+	}
+	var changed bool
+	for k, v := range attrs {
+		if k == "" {
+			return 0, nil, fmt.Errorf("attribute key is required")
+		}
+		if x, ok := target.Attributes[k]; ok && x == v || !ok && v == "" {
+			continue
+		}
+		if v != "" {
+			target.Attributes[k] = v
+		} else {
+			delete(target.Attributes, k)
+		}
+		changed = true
+	}
+	if changed {
+		instance.UpdatedAt = time.Now().UnixNano()
+		instance.SetMetadataAtPath(pth, collection.Metadata{
+			Attributes: target.Attributes,
+			UpdatedAt:  instance.UpdatedAt,
+		})
+		b.changes.add(thread, instance, caller, instance.Path, change{typ: EventAttributesChanged, path: pth})
+		if err := b.save(ctx, thread, instance, identity); err != nil {
+			return 0, nil, err
+		}
+		b.record(ctx, thread, key, caller, audit.OpPushPathAttributes, []string{pth}, instance.Path, instance.Path)
+	}
+
+	log.Debugf("pushed attributes for %s in %s", pth, key)
+	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, view(ctx, thread, instance)), nil
+}
+
+// PullPathAttributes returns the custom attributes at path.
+// Unlike access roles, attributes are not inherited from parent folders.
+func (b *Buckets) PullPathAttributes(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	identity did.Token,
+) (map[string]string, error) {
+	pth, err := parsePath(pth)
+	if err != nil {
+		return nil, err
+	}
+	instance, bpth, err := b.getBucketAndPath(ctx, thread, key, pth, identity)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dag.GetNodeAtPath(ctx, b.ipfs, bpth, instance.GetLinkEncryptionKey()); err != nil {
+		return nil, fmt.Errorf("could not resolve path: %s", pth)
+	}
+
+	log.Debugf("pulled attributes for %s in %s", pth, key)
+	return instance.Metadata[pth].Attributes, nil
+}
@@ -0,0 +1,108 @@
+package buckets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/textileio/go-buckets/audit"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+// ErrAuditDisabled indicates the library was created without an audit log.
+var ErrAuditDisabled = errors.New("audit log is not enabled")
+
+// AuditRecords returns audit records in thread matching q, newest first.
+// The owner and admins of the bucket q.Key can query records made by any identity.
+// Otherwise, only records made by the caller are returned.
+func (b *Buckets) AuditRecords(
+	ctx context.Context,
+	thread core.ID,
+	q audit.Query,
+	identity did.Token,
+) ([]*audit.Record, error) {
+	if b.audit == nil {
+		return nil, ErrAuditDisabled
+	}
+	caller, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	var admin bool
+	if q.Key != "" {
+		instance, err := b.getView(ctx, thread, q.Key, identity)
+		if err != nil {
+			return nil, err
+		}
+		groups, err := b.groupsOf(ctx, thread, caller, identity)
+		if err != nil {
+			return nil, err
+		}
+		admin = isBucketAdmin(instance, caller, groups)
+	}
+	if !admin {
+		if q.Identity == "" {
+			q.Identity = caller
+		} else if q.Identity != caller {
+			return nil, fmt.Errorf("permission denied")
+		}
+	}
+	q.Thread = thread
+	records, err := b.audit.Query(q)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("listed audit records in %s", thread)
+	return records, nil
+}
+
+// isBucketAdmin returns whether or not id owns instance or has the admin role at its root.
+// groups are the groups id is a member of, keyed by name, with their owners.
+func isBucketAdmin(instance *collection.Bucket, id did.DID, groups map[string]did.DID) bool {
+	if instance.Owner == id {
+		return true
+	}
+	md, ok := instance.Metadata[""]
+	if !ok {
+		return false
+	}
+	for p, r := range md.Roles {
+		if role, ok := grantedRole(instance, p, r, id, groups); ok && role == collection.AdminRole {
+			return true
+		}
+	}
+	return false
+}
+
+// record appends a mutating call made by the already validated caller to the audit log.
+// The pinned-byte delta is read from ctx.
+// The call has already been applied, so failing to record it is logged instead of returned.
+func (b *Buckets) record(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	caller did.DID,
+	op audit.Operation,
+	paths []string,
+	oldRoot, newRoot string,
+) {
+	if b.audit == nil {
+		return
+	}
+	if _, err := b.audit.Append(audit.Record{
+		Thread:      thread,
+		Key:         key,
+		Identity:    caller,
+		Operation:   op,
+		Paths:       paths,
+		OldRoot:     oldRoot,
+		NewRoot:     newRoot,
+		PinnedDelta: dag.GetPinnedBytes(ctx),
+	}); err != nil {
+		log.Errorf("recording %s of %s: %v", op, key, err)
+	}
+}
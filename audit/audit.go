@@ -0,0 +1,272 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/go-buckets/util"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+var (
+	log = logging.Logger("buckets-audit")
+
+	dsPrefix = ds.NewKey("/audit")
+
+	// Records are keyed by ID under recordsPrefix.
+	recordsPrefix = dsPrefix.ChildString("records")
+	// Index keys end in a record ID, so the records for an index key prefix sort in time order.
+	// /audit/thread/<thread>/<id>
+	threadIndex = dsPrefix.ChildString("thread")
+	// /audit/bucket/<thread>/<key>/<id>
+	bucketIndex = dsPrefix.ChildString("bucket")
+	// /audit/identity/<identity>/<id>
+	identityIndex = dsPrefix.ChildString("identity")
+)
+
+// idLen is the number of random bytes appended to a record ID.
+const idLen = 8
+
+// Operation is a mutating bucket operation.
+type Operation string
+
+// Operations recorded by the buckets library.
+const (
+	OpCreate              Operation = "create"
+	OpRemove              Operation = "remove"
+	OpFork                Operation = "fork"
+	OpImport              Operation = "import"
+	OpPushPaths           Operation = "push_paths"
+	OpSetPath             Operation = "set_path"
+	OpMovePath            Operation = "move_path"
+	OpRemovePath          Operation = "remove_path"
+	OpBatch               Operation = "batch"
+	OpPushPathAccessRoles Operation = "push_path_access_roles"
+	OpPushPathAttributes  Operation = "push_path_attributes"
+	OpRestoreVersion      Operation = "restore_version"
+	OpRotateKeys          Operation = "rotate_keys"
+	OpSetPrivacy          Operation = "set_privacy"
+)
+
+// Record describes a single mutating bucket operation.
+type Record struct {
+	// ID is the record ID, which sorts in time order.
+	ID string `json:"id"`
+	// Thread is the thread ID of the bucket.
+	Thread core.ID `json:"thread"`
+	// Key is the bucket key.
+	Key string `json:"key"`
+	// Identity is the DID of the identity that made the change.
+	Identity did.DID `json:"identity"`
+	// Operation is the type of change.
+	Operation Operation `json:"operation"`
+	// Paths are the bucket paths affected by the change, if any.
+	Paths []string `json:"paths"`
+	// OldRoot is the bucket root path before the change.
+	// It's empty for new buckets.
+	OldRoot string `json:"old_root"`
+	// NewRoot is the bucket root path after the change.
+	// It's empty for removed buckets.
+	NewRoot string `json:"new_root"`
+	// PinnedDelta is the change in pinned bytes caused by the operation.
+	PinnedDelta int64 `json:"pinned_delta"`
+	// Time is the time of the change.
+	Time time.Time `json:"time"`
+}
+
+// Query filters audit records.
+// Zero-valued fields match all records.
+type Query struct {
+	// Thread matches records for buckets in a thread.
+	Thread core.ID
+	// Key matches records for a bucket.
+	Key string
+	// Identity matches records made by an identity.
+	Identity did.DID
+	// Since matches records made at or after a time.
+	Since time.Time
+	// Until matches records made before a time.
+	Until time.Time
+	// Limit is the maximum number of records returned.
+	Limit int
+}
+
+func (q Query) matches(r *Record) bool {
+	if q.Thread.Defined() && r.Thread != q.Thread {
+		return false
+	}
+	if q.Key != "" && r.Key != q.Key {
+		return false
+	}
+	if q.Identity != "" && r.Identity != q.Identity {
+		return false
+	}
+	if !q.Since.IsZero() && r.Time.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !r.Time.Before(q.Until) {
+		return false
+	}
+	return true
+}
+
+// Log is a persistent, append-only log of bucket activity.
+// Records can't be changed or deleted once appended.
+type Log struct {
+	store ds.TxnDatastore
+	lk    sync.Mutex
+}
+
+// NewLog returns a new audit log backed by store.
+func NewLog(store ds.TxnDatastore) *Log {
+	return &Log{store: store}
+}
+
+// Append adds a record to the log.
+// The record ID and time are set by the log.
+func (l *Log) Append(r Record) (*Record, error) {
+	if r.Key == "" {
+		return nil, errors.New("bucket key is required")
+	}
+	if r.Identity == "" {
+		return nil, errors.New("identity is required")
+	}
+	if r.Operation == "" {
+		return nil, errors.New("operation is required")
+	}
+	r.Time = time.Now()
+	r.ID = fmt.Sprintf("%s-%s", timeID(r.Time), util.MakeToken(idLen))
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&r); err != nil {
+		return nil, err
+	}
+
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	txn, err := l.store.NewTransaction(false)
+	if err != nil {
+		return nil, fmt.Errorf("creating audit txn: %v", err)
+	}
+	defer txn.Discard()
+	if err := txn.Put(recordsPrefix.ChildString(r.ID), buf.Bytes()); err != nil {
+		return nil, fmt.Errorf("putting audit record: %v", err)
+	}
+	for _, prefix := range indexPrefixes(r.Thread, r.Key, r.Identity) {
+		if err := txn.Put(prefix.ChildString(r.ID), nil); err != nil {
+			return nil, fmt.Errorf("putting audit index: %v", err)
+		}
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, fmt.Errorf("committing audit txn: %v", err)
+	}
+	log.Debugf("recorded %s of %s by %s", r.Operation, r.Key, r.Identity)
+	return &r, nil
+}
+
+// indexPrefixes returns the index key prefixes of a record.
+func indexPrefixes(thread core.ID, key string, identity did.DID) []ds.Key {
+	var prefixes []ds.Key
+	if thread.Defined() {
+		prefixes = append(prefixes,
+			threadIndex.ChildString(thread.String()),
+			bucketIndex.ChildString(thread.String()).ChildString(key))
+	}
+	return append(prefixes, identityIndex.ChildString(string(identity)))
+}
+
+// prefix returns the key prefix that q reads records from.
+// The narrowest index that covers q is used; records read from an index are still matched against all of q.
+func (q Query) prefix() (prefix ds.Key, index bool) {
+	switch {
+	case q.Thread.Defined() && q.Key != "":
+		return bucketIndex.ChildString(q.Thread.String()).ChildString(q.Key), true
+	case q.Identity != "":
+		return identityIndex.ChildString(string(q.Identity)), true
+	case q.Thread.Defined():
+		return threadIndex.ChildString(q.Thread.String()), true
+	default:
+		return recordsPrefix, false
+	}
+}
+
+// Query returns records matching q, newest first.
+// Records are read from the narrowest index key prefix that covers q. Index keys end in record IDs,
+// which sort in time order, so keys are read in reverse order from the end of the time range and
+// reading stops at the start of the range or once the limit is reached.
+func (l *Log) Query(q Query) ([]*Record, error) {
+	if q.Limit < 0 {
+		return nil, errors.New("limit must not be negative")
+	}
+	prefix, index := q.prefix()
+	dq := query.Query{
+		Prefix:   prefix.String(),
+		Orders:   []query.Order{query.OrderByKeyDescending{}},
+		KeysOnly: index,
+	}
+	if !q.Until.IsZero() {
+		dq.Filters = []query.Filter{query.FilterKeyCompare{
+			Op:  query.LessThan,
+			Key: prefix.ChildString(timeID(q.Until)).String(),
+		}}
+	}
+	var since string
+	if !q.Since.IsZero() {
+		since = timeID(q.Since)
+	}
+	res, err := l.store.Query(dq)
+	if err != nil {
+		return nil, fmt.Errorf("querying audit records: %v", err)
+	}
+	defer res.Close()
+	var records []*Record
+	for e := range res.Next() {
+		if e.Error != nil {
+			return nil, fmt.Errorf("querying audit records: %v", e.Error)
+		}
+		id := ds.RawKey(e.Key).BaseNamespace()
+		if since != "" && id < since {
+			break
+		}
+		val := e.Value
+		if index {
+			val, err = l.store.Get(recordsPrefix.ChildString(id))
+			if err != nil {
+				return nil, fmt.Errorf("getting audit record: %v", err)
+			}
+		}
+		r, err := decode(val)
+		if err != nil {
+			return nil, err
+		}
+		if !q.matches(r) {
+			continue
+		}
+		records = append(records, r)
+		if q.Limit > 0 && len(records) == q.Limit {
+			break
+		}
+	}
+	return records, nil
+}
+
+// timeID returns the record ID prefix that sorts before all record IDs at or after t.
+func timeID(t time.Time) string {
+	return fmt.Sprintf("%020d", t.UnixNano())
+}
+
+func decode(val []byte) (*Record, error) {
+	var r Record
+	if err := gob.NewDecoder(bytes.NewReader(val)).Decode(&r); err != nil {
+		return nil, fmt.Errorf("decoding audit record: %v", err)
+	}
+	return &r, nil
+}
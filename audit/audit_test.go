@@ -0,0 +1,126 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/go-buckets/audit"
+	"github.com/textileio/go-threads/core/did"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/go-threads/db"
+)
+
+var (
+	alice = did.DID("did:key:alice")
+	bob   = did.DID("did:key:bob")
+)
+
+func TestLog_Append(t *testing.T) {
+	ds := db.NewTxMapDatastore()
+	defer ds.Close()
+	l := NewLog(ds)
+	id := thread.NewRandomIDV1()
+
+	r, err := l.Append(Record{
+		Thread:      id,
+		Key:         "key",
+		Identity:    alice,
+		Operation:   OpPushPaths,
+		Paths:       []string{"one", "two"},
+		OldRoot:     "/ipfs/old",
+		NewRoot:     "/ipfs/new",
+		PinnedDelta: 10,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, r.ID)
+	assert.False(t, r.Time.IsZero())
+
+	records, err := l.Query(Query{})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, r.ID, records[0].ID)
+	assert.Equal(t, id, records[0].Thread)
+	assert.Equal(t, []string{"one", "two"}, records[0].Paths)
+	assert.Equal(t, "/ipfs/old", records[0].OldRoot)
+	assert.Equal(t, "/ipfs/new", records[0].NewRoot)
+	assert.Equal(t, int64(10), records[0].PinnedDelta)
+
+	_, err = l.Append(Record{Thread: id, Identity: alice, Operation: OpCreate})
+	require.Error(t, err)
+	_, err = l.Append(Record{Thread: id, Key: "key", Operation: OpCreate})
+	require.Error(t, err)
+	_, err = l.Append(Record{Thread: id, Key: "key", Identity: alice})
+	require.Error(t, err)
+}
+
+func TestLog_Query(t *testing.T) {
+	ds := db.NewTxMapDatastore()
+	defer ds.Close()
+	l := NewLog(ds)
+	id := thread.NewRandomIDV1()
+
+	r1, err := l.Append(Record{Thread: id, Key: "key", Identity: alice, Operation: OpCreate})
+	require.NoError(t, err)
+	r2, err := l.Append(Record{Thread: id, Key: "key", Identity: bob, Operation: OpSetPath})
+	require.NoError(t, err)
+	r3, err := l.Append(Record{Thread: id, Key: "other", Identity: alice, Operation: OpCreate})
+	require.NoError(t, err)
+	_, err = l.Append(Record{Thread: thread.NewRandomIDV1(), Key: "key", Identity: alice, Operation: OpCreate})
+	require.NoError(t, err)
+
+	// Newest first
+	records, err := l.Query(Query{Thread: id})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, r3.ID, records[0].ID)
+	assert.Equal(t, r2.ID, records[1].ID)
+	assert.Equal(t, r1.ID, records[2].ID)
+
+	records, err = l.Query(Query{Thread: id, Key: "key"})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	records, err = l.Query(Query{Thread: id, Identity: alice})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, r3.ID, records[0].ID)
+	assert.Equal(t, r1.ID, records[1].ID)
+
+	records, err = l.Query(Query{Identity: bob})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, r2.ID, records[0].ID)
+
+	records, err = l.Query(Query{Key: "key"})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	records, err = l.Query(Query{Thread: id, Since: r2.Time})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, r3.ID, records[0].ID)
+
+	records, err = l.Query(Query{Thread: id, Until: r2.Time})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, r1.ID, records[0].ID)
+
+	records, err = l.Query(Query{Thread: id, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, r3.ID, records[0].ID)
+
+	records, err = l.Query(Query{Thread: id, Identity: bob, Since: r1.Time, Until: r3.Time, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, r2.ID, records[0].ID)
+
+	records, err = l.Query(Query{Since: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	_, err = l.Query(Query{Limit: -1})
+	require.Error(t, err)
+}
@@ -0,0 +1,293 @@
+package buckets
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	c "github.com/ipfs/go-cid"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets/audit"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-buckets/pinning"
+	"github.com/textileio/go-buckets/util"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+// BatchOpType is the type of operation in a batch.
+type BatchOpType int
+
+const (
+	// BatchSetPath sets a path to an existing UnixFS dag.
+	BatchSetPath BatchOpType = iota
+	// BatchMovePath moves a path to another path.
+	BatchMovePath
+	// BatchRemovePath removes a path.
+	BatchRemovePath
+	// BatchPutPath adds data at a path.
+	BatchPutPath
+)
+
+// String returns the string representation of the operation type.
+func (t BatchOpType) String() string {
+	switch t {
+	case BatchSetPath:
+		return "set"
+	case BatchMovePath:
+		return "move"
+	case BatchRemovePath:
+		return "remove"
+	case BatchPutPath:
+		return "put"
+	default:
+		return "invalid"
+	}
+}
+
+// BatchOp is a single operation in a batch.
+type BatchOp struct {
+	// Type is the operation type.
+	Type BatchOpType
+	// Path is the target path, or the source path of a move.
+	Path string
+	// ToPath is the destination path of a move.
+	ToPath string
+	// Cid is the UnixFS dag to set at Path.
+	Cid c.Cid
+	// Data is the file content to put at Path.
+	Data []byte
+}
+
+// Batch applies a list of operations to a bucket as a single update.
+// The operations are applied in order, resulting in a single new root.
+// If any operation fails, the entire batch is rejected and the bucket is left unchanged.
+// The final root is pinned once, and the replaced root is only unpinned after the bucket is saved.
+func (b *Buckets) Batch(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	ops []BatchOp,
+	root path.Resolved,
+	identity did.Token,
+) (int64, *Bucket, error) {
+	if len(ops) == 0 {
+		return 0, nil, fmt.Errorf("batch is empty")
+	}
+
+	caller, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	lk := b.locks.Get(lock(key))
+	lk.Acquire()
+	defer lk.Release()
+
+	ctx, instance, err := b.getSafe(ctx, thread, key, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+	if root != nil && root.String() != instance.Path {
+		return 0, nil, ErrNonFastForward
+	}
+	linkKey := instance.GetLinkEncryptionKey()
+	original, err := util.NewResolvedPath(instance.Path)
+	if err != nil {
+		return 0, nil, fmt.Errorf("resolving path: %v", err)
+	}
+
+	// Build the dag for all operations without pinning or charging the owner
+	stage := b.unpinned()
+	changes := make([]change, len(ops))
+	for i, op := range ops {
+		_, changes[i], err = stage.applyBatchOp(ctx, thread, instance, op, identity)
+		if err != nil {
+			return 0, nil, fmt.Errorf("applying %s operation %d: %v", op.Type, i, err)
+		}
+	}
+	current, err := util.NewResolvedPath(instance.Path)
+	if err != nil {
+		return 0, nil, fmt.Errorf("resolving path: %v", err)
+	}
+	changed := current.Cid() != original.Cid()
+
+	// Pin the final root once
+	ctx = dag.NewOwnerContext(ctx, instance.Owner)
+	if changed {
+		ctx, err = dag.PinNodeAndBranch(ctx, b.ipfs, b.pinner, current, linkKey)
+		if err != nil {
+			return 0, nil, fmt.Errorf("pinning batch root: %v", err)
+		}
+	}
+	versions, err := pinnedVersions(instance)
+	if err != nil {
+		return 0, nil, err
+	}
+	rollback := func(ctx context.Context, err error) (int64, *Bucket, error) {
+		if !changed {
+			return 0, nil, err
+		}
+		keep := append([]path.Resolved{original}, versions...)
+		if _, rerr := dag.UnpinNodesAndBranches(
+			ctx,
+			b.ipfs,
+			b.pinner,
+			[]path.Resolved{current},
+			keep,
+			linkKey,
+		); rerr != nil {
+			log.Errorf("unpinning batch root for %s: %v", key, rerr)
+		}
+		return 0, nil, err
+	}
+
+	// The original root stays pinned until the batch is saved
+	ctx, err = b.addVersion(ctx, instance, caller, b.pinnedVersions > 0)
+	if err != nil {
+		return rollback(ctx, err)
+	}
+	b.changes.add(thread, instance, caller, original.String(), changes...)
+	if err := b.saveAndPublish(ctx, thread, instance, identity); err != nil {
+		return rollback(ctx, err)
+	}
+	if changed && !isPinnedRoot(instance, original.String()) {
+		ctx, err = b.unpinReplacedRoot(ctx, instance, original)
+		if err != nil {
+			log.Errorf("unpinning original root for %s: %v", key, err)
+		}
+	}
+	b.record(ctx, thread, key, caller, audit.OpBatch, batchPaths(changes), original.String(), instance.Path)
+
+	log.Debugf("applied batch of %d operations to %s", len(ops), key)
+	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, view(ctx, thread, instance)), nil
+}
+
+// applyBatchOp applies op to the bucket instance without saving it, returning the resulting change.
+func (b *Buckets) applyBatchOp(
+	ctx context.Context,
+	thread core.ID,
+	instance *collection.Bucket,
+	op BatchOp,
+	identity did.Token,
+) (context.Context, change, error) {
+	var err error
+	switch op.Type {
+	case BatchSetPath:
+		if !op.Cid.Defined() {
+			return ctx, change{}, fmt.Errorf("cid is required")
+		}
+		ch := b.pathChange(ctx, instance, trimSlash(op.Path))
+		ctx, err = b.applySetPath(ctx, thread, instance, op.Path, op.Cid, identity)
+		return ctx, ch, err
+	case BatchMovePath:
+		var dest string
+		ctx, dest, err = b.applyMovePath(ctx, thread, instance, op.Path, op.ToPath, identity)
+		return ctx, change{typ: EventPathMoved, path: trimSlash(op.Path), toPath: dest}, err
+	case BatchRemovePath:
+		ctx, err = b.applyRemovePath(ctx, thread, instance, op.Path, identity)
+		return ctx, change{typ: EventPathRemoved, path: trimSlash(op.Path)}, err
+	case BatchPutPath:
+		ch := b.pathChange(ctx, instance, trimSlash(op.Path))
+		ctx, err = b.applyPushPath(ctx, thread, instance, op.Path, bytes.NewReader(op.Data), identity)
+		return ctx, ch, err
+	default:
+		return ctx, change{}, fmt.Errorf("invalid operation type: %d", op.Type)
+	}
+}
+
+// batchPaths returns the paths affected by a batch, including move destinations.
+func batchPaths(changes []change) []string {
+	var paths []string
+	for _, c := range changes {
+		paths = append(paths, c.path)
+		if c.typ == EventPathMoved {
+			paths = append(paths, c.toPath)
+		}
+	}
+	return paths
+}
+
+// pinnedVersions returns the pinned roots in the bucket's history, excluding the current root.
+func pinnedVersions(instance *collection.Bucket) ([]path.Resolved, error) {
+	var versions []path.Resolved
+	for _, r := range instance.History {
+		if !r.Pinned || r.Path == instance.Path {
+			continue
+		}
+		p, err := util.NewResolvedPath(r.Path)
+		if err != nil {
+			return nil, fmt.Errorf("resolving path: %v", err)
+		}
+		versions = append(versions, p)
+	}
+	return versions, nil
+}
+
+// isPinnedRoot returns whether or not the root at pth is pinned in the bucket's history.
+func isPinnedRoot(instance *collection.Bucket, pth string) bool {
+	for _, r := range instance.History {
+		if r.Path == pth && r.Pinned {
+			return true
+		}
+	}
+	return false
+}
+
+// unpinReplacedRoot unpins the nodes of replaced that are not reachable from the bucket's
+// current root or pinned versions.
+func (b *Buckets) unpinReplacedRoot(
+	ctx context.Context,
+	instance *collection.Bucket,
+	replaced path.Resolved,
+) (context.Context, error) {
+	current, err := util.NewResolvedPath(instance.Path)
+	if err != nil {
+		return ctx, fmt.Errorf("resolving path: %v", err)
+	}
+	versions, err := pinnedVersions(instance)
+	if err != nil {
+		return ctx, err
+	}
+	return dag.UnpinNodesAndBranches(
+		ctx,
+		b.ipfs,
+		b.pinner,
+		[]path.Resolved{replaced},
+		append([]path.Resolved{current}, versions...),
+		instance.GetLinkEncryptionKey(),
+	)
+}
+
+// unpinned returns a copy of b that builds dags without pinning them.
+func (b *Buckets) unpinned() *Buckets {
+	u := *b
+	u.pinner = discardPinner{}
+	return &u
+}
+
+// discardPinner is a pinning.Pinner that ignores all pins.
+type discardPinner struct{}
+
+var _ pinning.Pinner = discardPinner{}
+
+func (discardPinner) Add(context.Context, c.Cid) error {
+	return nil
+}
+
+func (discardPinner) Update(context.Context, c.Cid, c.Cid) error {
+	return nil
+}
+
+func (discardPinner) Rm(context.Context, c.Cid) error {
+	return nil
+}
+
+func (discardPinner) IsPinned(context.Context, c.Cid) (bool, error) {
+	return false, nil
+}
+
+func (discardPinner) Ls(context.Context) ([]c.Cid, error) {
+	return nil, nil
+}
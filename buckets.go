@@ -3,23 +3,29 @@ package buckets
 // @todo: Validate all thread IDs
 // @todo: Validate all identities
 // @todo: Clean up error messages
-// @todo: Enforce fast-forward-only in SetPath and MovePath, PushPathAccessRoles
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	c "github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
 	logging "github.com/ipfs/go-log/v2"
 	iface "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/textileio/go-buckets/audit"
 	"github.com/textileio/go-buckets/collection"
 	"github.com/textileio/go-buckets/dag"
 	"github.com/textileio/go-buckets/dns"
 	"github.com/textileio/go-buckets/ipns"
+	"github.com/textileio/go-buckets/pinning"
 	"github.com/textileio/go-buckets/util"
 	dbc "github.com/textileio/go-threads/api/client"
 	"github.com/textileio/go-threads/core/did"
@@ -43,6 +49,9 @@ var (
 	// ErrNonFastForward is returned when an update in non-fast-forward.
 	ErrNonFastForward = errors.New("update is non-fast-forward")
 
+	// ErrInvalidRange is returned when a read range lies outside of a file.
+	ErrInvalidRange = dag.ErrInvalidRange
+
 	movePathRegexp = regexp.MustCompile("/ipfs/([^/]+)/")
 )
 
@@ -85,12 +94,21 @@ type Buckets struct {
 	net *nc.Client
 	db  *dbc.Client
 	c   *collection.Buckets
+	g   *collection.Groups
+
+	ipfs   iface.CoreAPI
+	pinner pinning.Pinner
+	ipns   *ipns.Manager
+	dns    *dns.Manager
+	audit  *audit.Log
+	server core.Identity
 
-	ipfs iface.CoreAPI
-	ipns *ipns.Manager
-	dns  *dns.Manager
+	uploads *uploadSessions
+	changes *changeLog
+	locks   *nutil.SemaphorePool
 
-	locks *nutil.SemaphorePool
+	pinnedVersions int
+	maxVersions    int
 }
 
 var _ nutil.SemaphoreKey = (*lock)(nil)
@@ -102,30 +120,73 @@ func (l lock) Key() string {
 }
 
 // NewBuckets returns a new buckets library.
+// Bucket data is added to ipfs and persisted with pinner.
+// Mutating calls are recorded in audit if it's not nil.
 func NewBuckets(
 	net *nc.Client,
 	db *dbc.Client,
 	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
 	ipns *ipns.Manager,
 	dns *dns.Manager,
+	audit *audit.Log,
+	opts ...BucketsOption,
 ) (*Buckets, error) {
-	bc, err := collection.NewBuckets(db)
+	args := &BucketsOptions{
+		PinnedVersions: DefaultPinnedVersions,
+		MaxVersions:    DefaultMaxVersions,
+	}
+	for _, opt := range opts {
+		opt(args)
+	}
+	if args.PinnedVersions < 0 || args.MaxVersions < 1 {
+		return nil, fmt.Errorf("invalid version history limits")
+	}
+	if args.UploadSessionStore == nil {
+		args.UploadSessionStore = dssync.MutexWrap(ds.NewMapDatastore())
+	}
+	if args.ServerIdentity == nil {
+		sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("generating server identity: %v", err)
+		}
+		args.ServerIdentity = core.NewLibp2pIdentity(sk)
+	}
+	server, err := args.ServerIdentity.GetPublic().DID()
+	if err != nil {
+		return nil, fmt.Errorf("getting server did: %v", err)
+	}
+	bc, err := collection.NewBuckets(db, server)
 	if err != nil {
 		return nil, fmt.Errorf("getting buckets collection: %v", err)
 	}
+	gc, err := collection.NewGroups(db)
+	if err != nil {
+		return nil, fmt.Errorf("getting groups collection: %v", err)
+	}
 	return &Buckets{
-		net:   net,
-		db:    db,
-		c:     bc,
-		ipfs:  ipfs,
-		ipns:  ipns,
-		dns:   dns,
-		locks: nutil.NewSemaphorePool(1),
+		net:     net,
+		db:      db,
+		c:       bc,
+		g:       gc,
+		ipfs:    ipfs,
+		pinner:  pinner,
+		ipns:    ipns,
+		dns:     dns,
+		audit:   audit,
+		server:  args.ServerIdentity,
+		uploads: newUploadSessions(ipfs, pinner, args.UploadSessionStore),
+		changes: newChangeLog(),
+		locks:   nutil.NewSemaphorePool(1),
+
+		pinnedVersions: args.PinnedVersions,
+		maxVersions:    args.MaxVersions,
 	}, nil
 }
 
 // Close it down.
 func (b *Buckets) Close() error {
+	b.uploads.close()
 	b.locks.Stop()
 	return nil
 }
@@ -138,8 +199,30 @@ func (b *Buckets) DB() *dbc.Client {
 	return b.db
 }
 
+// serverToken returns a token for the server identity.
+func (b *Buckets) serverToken(ctx context.Context) (did.Token, error) {
+	doc, err := b.net.GetServices(ctx)
+	if err != nil {
+		return "", fmt.Errorf("getting services: %v", err)
+	}
+	token, err := b.server.Token(doc.ID, time.Hour)
+	if err != nil {
+		return "", fmt.Errorf("getting server token: %v", err)
+	}
+	return token, nil
+}
+
+// ValidateIdentity returns the DID of a valid identity token.
+func (b *Buckets) ValidateIdentity(ctx context.Context, identity did.Token) (did.DID, error) {
+	_, id, err := b.net.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return "", fmt.Errorf("validating identity: %v", err)
+	}
+	return id, nil
+}
+
 func (b *Buckets) Get(ctx context.Context, thread core.ID, key string, identity did.Token) (*Bucket, error) {
-	instance, err := b.c.GetSafe(ctx, thread, key, collection.WithIdentity(identity))
+	instance, err := b.getView(ctx, thread, key, identity)
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +236,7 @@ func (b *Buckets) GetLinks(
 	key, pth string,
 	identity did.Token,
 ) (links Links, err error) {
-	instance, err := b.c.GetSafe(ctx, thread, key, collection.WithIdentity(identity))
+	instance, err := b.getView(ctx, thread, key, identity)
 	if err != nil {
 		return links, err
 	}
@@ -225,37 +308,48 @@ func (b *Buckets) List(ctx context.Context, thread core.ID, identity did.Token)
 }
 
 func (b *Buckets) Remove(ctx context.Context, thread core.ID, key string, identity did.Token) (int64, error) {
+	caller, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return 0, err
+	}
+
 	lk := b.locks.Get(lock(key))
 	lk.Acquire()
 	defer lk.Release()
 
-	instance, err := b.c.GetSafe(ctx, thread, key, collection.WithIdentity(identity))
+	ctx, instance, err := b.getSafe(ctx, thread, key, identity)
 	if err != nil {
 		return 0, err
 	}
+	ctx = dag.NewOwnerContext(ctx, instance.Owner)
 	if err := b.c.Delete(ctx, thread, key, collection.WithIdentity(identity)); err != nil {
 		return 0, fmt.Errorf("deleting bucket: %v", err)
 	}
 
+	// Unpin the current root along with all pinned versions
 	buckPath, err := util.NewResolvedPath(instance.Path)
 	if err != nil {
 		return 0, fmt.Errorf("resolving path: %v", err)
 	}
-	linkKey := instance.GetLinkEncryptionKey()
-	if linkKey != nil {
-		ctx, err = dag.UnpinNodeAndBranch(ctx, b.ipfs, buckPath, linkKey)
-		if err != nil {
-			return 0, err
+	pths := []path.Resolved{buckPath}
+	for _, r := range instance.History {
+		if !r.Pinned || r.Path == instance.Path {
+			continue
 		}
-	} else {
-		ctx, err = dag.UnpinPath(ctx, b.ipfs, buckPath)
+		p, err := util.NewResolvedPath(r.Path)
 		if err != nil {
-			return 0, err
+			return 0, fmt.Errorf("resolving path: %v", err)
 		}
+		pths = append(pths, p)
+	}
+	ctx, err = dag.UnpinNodesAndBranches(ctx, b.ipfs, b.pinner, pths, nil, instance.GetLinkEncryptionKey())
+	if err != nil {
+		return 0, err
 	}
 	if err := b.ipns.RemoveKey(ctx, key); err != nil {
 		return 0, err
 	}
+	b.record(ctx, thread, key, caller, audit.OpRemove, nil, instance.Path, "")
 
 	log.Debugf("removed %s", key)
 	return dag.GetPinnedBytes(ctx), nil
@@ -267,7 +361,7 @@ func (b *Buckets) saveAndPublish(
 	instance *collection.Bucket,
 	identity did.Token,
 ) error {
-	if err := b.c.Save(ctx, thread, instance, collection.WithIdentity(identity)); err != nil {
+	if err := b.save(ctx, thread, instance, identity); err != nil {
 		return fmt.Errorf("saving bucket: %v", err)
 	}
 	go b.ipns.Publish(path.New(instance.Path), instance.Key)
@@ -0,0 +1,136 @@
+// Package car reads and writes CARv1 (Content Addressable aRchive) files.
+// See https://ipld.io/specs/transport/car/carv1.
+package car
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ipfs/go-cid"
+	cbor "github.com/ipfs/go-ipld-cbor"
+)
+
+// MaxSectionSize is the maximum size of a header or block section.
+const MaxSectionSize = 32 << 20
+
+// ErrSectionTooLarge indicates a header or block section exceeds MaxSectionSize.
+var ErrSectionTooLarge = errors.New("section exceeds max size")
+
+func init() {
+	cbor.RegisterCborType(Header{})
+}
+
+// Header is a CAR file header.
+type Header struct {
+	Roots   []cid.Cid `refmt:"roots"`
+	Version uint64    `refmt:"version"`
+}
+
+// Writer writes blocks to a CAR file.
+type Writer struct {
+	w   io.Writer
+	buf [binary.MaxVarintLen64]byte
+}
+
+// NewWriter writes a CAR header with roots to w and returns a Writer for blocks.
+func NewWriter(w io.Writer, roots []cid.Cid) (*Writer, error) {
+	header, err := cbor.DumpObject(&Header{Roots: roots, Version: 1})
+	if err != nil {
+		return nil, fmt.Errorf("encoding header: %v", err)
+	}
+	cw := &Writer{w: w}
+	if err := cw.writeSection(header); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+// Put writes a block. Blocks are not checked for duplicates.
+func (w *Writer) Put(c cid.Cid, data []byte) error {
+	return w.writeSection(c.Bytes(), data)
+}
+
+func (w *Writer) writeSection(parts ...[]byte) error {
+	var size int
+	for _, p := range parts {
+		size += len(p)
+	}
+	n := binary.PutUvarint(w.buf[:], uint64(size))
+	if _, err := w.w.Write(w.buf[:n]); err != nil {
+		return err
+	}
+	for _, p := range parts {
+		if _, err := w.w.Write(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reader reads blocks from a CAR file.
+type Reader struct {
+	Header Header
+	r      *bufio.Reader
+}
+
+// NewReader reads a CAR header from r and returns a Reader for blocks.
+func NewReader(r io.Reader) (*Reader, error) {
+	cr := &Reader{r: bufio.NewReader(r)}
+	data, err := cr.readSection()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("reading header: %v", io.ErrUnexpectedEOF)
+	} else if err != nil {
+		return nil, fmt.Errorf("reading header: %v", err)
+	}
+	if err := cbor.DecodeInto(data, &cr.Header); err != nil {
+		return nil, fmt.Errorf("decoding header: %v", err)
+	}
+	if cr.Header.Version != 1 {
+		return nil, fmt.Errorf("unsupported version: %d", cr.Header.Version)
+	}
+	if len(cr.Header.Roots) == 0 {
+		return nil, fmt.Errorf("header has no roots")
+	}
+	return cr, nil
+}
+
+// Next returns the next block.
+// io.EOF is returned when there are no more blocks.
+// Block data is not checked against the cid.
+func (r *Reader) Next() (cid.Cid, []byte, error) {
+	data, err := r.readSection()
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+	n, c, err := cid.CidFromBytes(data)
+	if err != nil {
+		return cid.Undef, nil, fmt.Errorf("decoding cid: %v", err)
+	}
+	return c, data[n:], nil
+}
+
+// readSection reads a varint length-prefixed section.
+// io.EOF is only returned if there are no more sections.
+func (r *Reader) readSection() ([]byte, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		return nil, fmt.Errorf("empty section")
+	}
+	if size > MaxSectionSize {
+		return nil, ErrSectionTooLarge
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return data, nil
+}
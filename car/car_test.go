@@ -0,0 +1,65 @@
+package car_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/go-buckets/car"
+)
+
+func TestWriterReader(t *testing.T) {
+	blocks := [][]byte{[]byte("one"), []byte("two"), []byte("three")}
+	cids := make([]cid.Cid, len(blocks))
+	for i, b := range blocks {
+		cids[i] = newCid(t, b)
+	}
+
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, cids[:2])
+	require.NoError(t, err)
+	for i, b := range blocks {
+		err = w.Put(cids[i], b)
+		require.NoError(t, err)
+	}
+
+	r, err := NewReader(buf)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), r.Header.Version)
+	assert.Equal(t, cids[:2], r.Header.Roots)
+	for i, b := range blocks {
+		c, data, err := r.Next()
+		require.NoError(t, err)
+		assert.True(t, cids[i].Equals(c))
+		assert.Equal(t, b, data)
+	}
+	_, _, err = r.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestReader_Truncated(t *testing.T) {
+	c := newCid(t, []byte("one"))
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, []cid.Cid{c})
+	require.NoError(t, err)
+	err = w.Put(c, []byte("one"))
+	require.NoError(t, err)
+
+	_, err = NewReader(bytes.NewReader(nil))
+	assert.Error(t, err)
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	require.NoError(t, err)
+	_, _, err = r.Next()
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func newCid(t *testing.T, data []byte) cid.Cid {
+	h, err := multihash.Sum(data, multihash.SHA2_256, -1)
+	require.NoError(t, err)
+	return cid.NewCidV1(cid.Raw, h)
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets/audit"
+	"github.com/textileio/go-buckets/cmd"
+	"github.com/textileio/go-threads/core/did"
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "List bucket activity",
+	Long: `Lists the remote bucket's audit log, most recent first.
+
+Every change to the bucket is recorded with the identity that made it.
+The bucket owner and admins can list changes made by any identity. Otherwise, only your own changes are listed.
+Durations are relative to now, e.g., "--since 24h" lists changes made in the last day.
+`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		query := audit.Query{}
+		by, err := c.Flags().GetString("by")
+		cmd.ErrCheck(err)
+		query.Identity = did.DID(by)
+		since, err := c.Flags().GetDuration("since")
+		cmd.ErrCheck(err)
+		if since > 0 {
+			query.Since = time.Now().Add(-since)
+		}
+		until, err := c.Flags().GetDuration("until")
+		cmd.ErrCheck(err)
+		if until > 0 {
+			query.Until = time.Now().Add(-until)
+		}
+		query.Limit, err = c.Flags().GetInt("limit")
+		cmd.ErrCheck(err)
+		format, err := c.Flags().GetString("format")
+		cmd.ErrCheck(err)
+
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		records, err := buck.AuditRecords(ctx, query)
+		cmd.ErrCheck(err)
+
+		if Format(format) == JSONFormat {
+			cmd.RenderJSON(records)
+			return
+		}
+		var data [][]string
+		for _, r := range records {
+			paths := make([]string, len(r.Paths))
+			for i, p := range r.Paths {
+				paths[i] = "/" + strings.TrimPrefix(p, "/")
+			}
+			data = append(data, []string{
+				r.Time.Format(time.RFC3339),
+				string(r.Identity),
+				string(r.Operation),
+				strings.Join(paths, ", "),
+				strings.TrimPrefix(r.NewRoot, "/ipfs/"),
+				strconv.FormatInt(r.PinnedDelta, 10),
+			})
+		}
+		if len(data) > 0 {
+			cmd.RenderTable([]string{"date", "identity", "operation", "paths", "root", "pinned"}, data)
+		}
+		cmd.Message("Found %d records", aurora.White(len(data)).Bold())
+	},
+}
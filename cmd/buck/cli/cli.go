@@ -37,17 +37,34 @@ func Init(baseCmd *cobra.Command) {
 		rootCmd,
 		statusCmd,
 		lsCmd,
+		findCmd,
 		pushCmd,
 		pullCmd,
+		logCmd,
+		restoreCmd,
+		forkCmd,
+		exportCmd,
+		importCmd,
 		addCmd,
-		//watchCmd,
+		watchCmd,
 		catCmd,
 		destroyCmd,
 		encryptCmd,
 		decryptCmd,
 		rolesCmd,
+		groupsCmd,
+		metaCmd,
+		verifyCmd,
+		rotateKeysCmd,
+		privacyCmd,
+		shareCmd,
+		auditCmd,
+		eventsCmd,
 	)
-	rolesCmd.AddCommand(rolesGrantCmd, rolesLsCmd)
+	rolesCmd.AddCommand(rolesGrantCmd, rolesLsCmd, rolesExplainCmd)
+	groupsCmd.AddCommand(groupsCreateCmd, groupsLsCmd, groupsAddCmd, groupsRemoveCmd, groupsDeleteCmd)
+	shareCmd.AddCommand(shareLsCmd, shareRevokeCmd)
+	metaCmd.AddCommand(metaSetCmd, metaGetCmd)
 
 	baseCmd.PersistentFlags().String("key", "", "Bucket key")
 	baseCmd.PersistentFlags().String("thread", "", "Thread ID")
@@ -62,21 +79,72 @@ func Init(baseCmd *cobra.Command) {
 	initCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
 	initCmd.Flags().BoolP("quiet", "q", false, "Write minimal output")
 
+	statusCmd.Flags().Bool("ignored", false, "Shows files skipped by .buckignore files if true")
+
+	lsCmd.Flags().BoolP("recursive", "R", false, "Lists all nested objects if true")
+
+	findCmd.Flags().StringP("name", "n", "", "Object name glob pattern")
+	findCmd.Flags().StringP("type", "t", "", "Object type: f (file), d (directory)")
+	findCmd.Flags().Int64("min-size", 0, "Minimum object size in bytes")
+	findCmd.Flags().Int64("max-size", 0, "Maximum object size in bytes")
+	findCmd.Flags().Duration("newer", 0, "Finds objects updated within the duration")
+	findCmd.Flags().Duration("older", 0, "Finds objects not updated within the duration")
+	findCmd.Flags().StringArrayP("attr", "a", nil, "Metadata attribute key=value (repeatable)")
+	findCmd.Flags().Int("limit", 0, "Maximum number of objects to find (unlimited by default)")
+
 	pushCmd.Flags().BoolP("force", "f", false, "Allows non-fast-forward updates if true")
+	pushCmd.Flags().BoolP("merge", "m", false, "Merges remote changes before pushing if true")
 	pushCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
 	pushCmd.Flags().BoolP("quiet", "q", false, "Write minimal output")
 
 	pullCmd.Flags().BoolP("force", "f", false, "Force pull all remote files if true")
 	pullCmd.Flags().Bool("hard", false, "Discards local changes if true")
+	pullCmd.Flags().BoolP("merge", "m", false, "Merges local and remote changes if true")
 	pullCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
 	pullCmd.Flags().BoolP("quiet", "q", false, "Write minimal output")
 
+	restoreCmd.Flags().Bool("hard", false, "Discards local changes if true")
+	restoreCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
+	restoreCmd.Flags().BoolP("quiet", "q", false, "Write minimal output")
+
+	forkCmd.Flags().StringP("name", "n", "", "Name of the new bucket (defaults to the bucket's name)")
+	forkCmd.Flags().BoolP("private", "p", false, "Obfuscates files and folders with encryption")
+	forkCmd.Flags().Bool("roles", false, "Copies access roles from the bucket if true")
+	forkCmd.Flags().String("to-thread", "", "Thread ID for the new bucket (a new thread is created by default)")
+
+	importCmd.Flags().StringP("name", "n", "", "Name of the new bucket (defaults to the archived bucket's name)")
+	importCmd.Flags().BoolP("private", "p", false, "Obfuscates files and folders with encryption")
+	importCmd.Flags().Bool("regenerate-keys", false, "Creates new encryption keys instead of re-using archived keys if true")
+	importCmd.Flags().String("to-thread", "", "Thread ID for the new bucket (a new thread is created by default)")
+
 	addCmd.Flags().BoolP("yes", "y", false, "Skips confirmations prompts to always overwrite files and merge folders")
 
+	catCmd.Flags().String("archive", "", "Cats the path as an archive in the provided format. Options: [tar,tar.gz,zip]")
+
 	encryptCmd.Flags().StringP("password", "p", "", "Encryption password")
 	decryptCmd.Flags().StringP("password", "p", "", "Decryption password")
 
 	rolesGrantCmd.Flags().StringP("role", "r", "", "Access role: none, reader, writer, admin")
+	rolesExplainCmd.Flags().Bool("tree", false, "Lists the roles of every object with roles if true")
+	rolesExplainCmd.Flags().String("format", "default", "Display the report in the provided format. Options: [default,json]")
+
+	rotateKeysCmd.Flags().Bool("file-keys", false, "Also rotates all file encryption keys if true")
+	rotateKeysCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
+
+	privacyCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
+
+	shareCmd.Flags().Duration("expires", share.DefaultTTL, "Duration until the share link expires")
+	shareCmd.Flags().Int("max-downloads", 0, "Maximum number of file downloads (unlimited by default)")
+
+	auditCmd.Flags().String("by", "", "Lists changes made by an identity DID")
+	auditCmd.Flags().Duration("since", 0, "Lists changes made within the duration")
+	auditCmd.Flags().Duration("until", 0, "Lists changes made before the duration")
+	auditCmd.Flags().Int("limit", 0, "Maximum number of changes to list (unlimited by default)")
+	auditCmd.Flags().String("format", "default", "Display the changes in the provided format. Options: [default,json]")
+
+	eventsCmd.Flags().String("format", "default", "Display changes in the provided format. Options: [default,json]")
+
+	verifyCmd.Flags().String("format", "default", "Display the report in the provided format. Options: [default,json]")
 
 	linksCmd.Flags().String("format", "default", "Display URL links in the provided format. Options: [default,json]")
 }
@@ -139,9 +207,13 @@ var statusCmd = &cobra.Command{
 		"st",
 	},
 	Short: "Show bucket object changes",
-	Long:  `Displays paths that have been added to and paths that have been removed or differ from the local bucket root.`,
-	Args:  cobra.ExactArgs(0),
+	Long: `Displays paths that have been added to and paths that have been removed or differ from the local bucket root.
+
+Paths matching patterns in .buckignore files are skipped. Use --ignored to show them.`,
+	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
+		showIgnored, err := c.Flags().GetBool("ignored")
+		cmd.ErrCheck(err)
 		conf, err := bucks.NewConfigFromCmd(c, ".")
 		cmd.ErrCheck(err)
 		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
@@ -151,12 +223,26 @@ var statusCmd = &cobra.Command{
 		diff, err := buck.DiffLocal()
 		cmd.ErrCheck(err)
 		if len(diff) == 0 {
-			cmd.End("Everything up-to-date")
+			if !showIgnored {
+				cmd.End("Everything up-to-date")
+			}
+			cmd.Message("Everything up-to-date")
 		}
 		for _, c := range diff {
 			cf := local.ChangeColor(c.Type)
 			cmd.Message("%s  %s", cf(local.ChangeType(c.Type)), cf(c.Rel))
 		}
+		if showIgnored {
+			ignored, err := buck.IgnoredLocal()
+			cmd.ErrCheck(err)
+			if len(ignored) == 0 {
+				cmd.End("No ignored files")
+			}
+			cmd.Message("Ignored files:")
+			for _, c := range ignored {
+				cmd.Message("  %s", aurora.BrightBlack(c.Rel))
+			}
+		}
 	},
 }
 
@@ -230,8 +316,10 @@ var lsCmd = &cobra.Command{
 		"list",
 	},
 	Short: "List top-level or nested bucket objects",
-	Long:  `Lists top-level or nested bucket objects.`,
-	Args:  cobra.MaximumNArgs(1),
+	Long: `Lists top-level or nested bucket objects.
+
+Use the --recursive flag to list all objects under path.`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(c *cobra.Command, args []string) {
 		conf, err := bucks.NewConfigFromCmd(c, ".")
 		cmd.ErrCheck(err)
@@ -243,7 +331,18 @@ var lsCmd = &cobra.Command{
 		if len(args) > 0 {
 			pth = args[0]
 		}
-		items, err := buck.ListRemotePath(ctx, pth)
+		recursive, err := c.Flags().GetBool("recursive")
+		cmd.ErrCheck(err)
+		var items []local.BucketItem
+		if recursive {
+			err = buck.WalkRemotePath(ctx, pth, func(rel string, item local.BucketItem) error {
+				item.Name = rel
+				items = append(items, item)
+				return nil
+			})
+		} else {
+			items, err = buck.ListRemotePath(ctx, pth)
+		}
 		cmd.ErrCheck(err)
 		var data [][]string
 		if len(items) > 0 {
@@ -273,8 +372,10 @@ var lsCmd = &cobra.Command{
 var catCmd = &cobra.Command{
 	Use:   "cat [path]",
 	Short: "Cat bucket objects at path",
-	Long:  `Cats bucket objects at path.`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Cats bucket objects at path.
+
+Use --archive to cat a folder and all of its descendants as a tar, tar.gz, or zip archive.`,
+	Args: cobra.ExactArgs(1),
 	Run: func(c *cobra.Command, args []string) {
 		conf, err := bucks.NewConfigFromCmd(c, ".")
 		cmd.ErrCheck(err)
@@ -282,6 +383,15 @@ var catCmd = &cobra.Command{
 		defer cancel()
 		buck, err := bucks.GetLocalBucket(ctx, conf)
 		cmd.ErrCheck(err)
+		archive, err := c.Flags().GetString("archive")
+		cmd.ErrCheck(err)
+		if archive != "" {
+			format, err := buckets.NewArchiveFormatFromString(archive)
+			cmd.ErrCheck(err)
+			err = buck.CatRemotePathArchive(ctx, args[0], os.Stdout, format)
+			cmd.ErrCheck(err)
+			return
+		}
 		err = buck.CatRemotePath(ctx, args[0], os.Stdout)
 		cmd.ErrCheck(err)
 	},
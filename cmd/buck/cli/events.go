@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/cmd"
+)
+
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Stream remote bucket changes",
+	Long: `Streams changes to the remote bucket as they happen.
+
+Changes are found by comparing bucket updates, so changes made through other
+daemons are shown too. Changes that add a bucket version are printed with the
+identity that made them. Changes to paths you can't read are not shown.
+Streaming stops when the remote bucket is removed.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		format, err := c.Flags().GetString("format")
+		cmd.ErrCheck(err)
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		events, err := buck.Listen(ctx)
+		cmd.ErrCheck(err)
+		if Format(format) != JSONFormat {
+			cmd.Success("Streaming changes to %s...", aurora.White(buck.Key()).Bold())
+		}
+		for e := range events {
+			cmd.ErrCheck(e.Err)
+			if Format(format) == JSONFormat {
+				cmd.RenderJSON(e.Event)
+				continue
+			}
+			if !e.Event.Identity.Defined() {
+				cmd.Message("%s %s %s",
+					e.Event.Time.Format(time.RFC3339),
+					aurora.Cyan(e.Event.Type),
+					formatEventPath(e.Event),
+				)
+				continue
+			}
+			cmd.Message("%s %s %s by %s",
+				e.Event.Time.Format(time.RFC3339),
+				aurora.Cyan(e.Event.Type),
+				formatEventPath(e.Event),
+				aurora.White(e.Event.Identity).Bold(),
+			)
+		}
+	},
+}
+
+func formatEventPath(e *buckets.Event) string {
+	switch e.Type {
+	case buckets.EventRootReplaced:
+		return strings.TrimPrefix(e.NewRoot, "/ipfs/")
+	case buckets.EventBucketRemoved:
+		return strings.TrimPrefix(e.OldRoot, "/ipfs/")
+	case buckets.EventPathMoved:
+		return "/" + e.Path + " -> /" + e.ToPath
+	default:
+		return "/" + e.Path
+	}
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/cmd"
+	"github.com/textileio/go-threads/core/thread"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Export the bucket to an archive",
+	Long: `Exports the current remote bucket root to a CAR (content addressable archive) file.
+
+The archive includes the bucket metadata, access roles, and encrypted data.
+Archives of private buckets also include their encryption keys, so store them securely.
+Use '-' to write the archive to stdout.
+Use 'buck import' to create a new bucket from the archive.
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+
+		var w io.Writer
+		if args[0] == "-" {
+			w = os.Stdout
+		} else {
+			file, err := os.Create(args[0])
+			cmd.ErrCheck(err)
+			defer file.Close()
+			w = file
+		}
+		err = buck.Export(ctx, w)
+		if err != nil && args[0] != "-" {
+			_ = os.Remove(args[0])
+		}
+		cmd.ErrCheck(err)
+		if args[0] != "-" {
+			cmd.Success("Exported bucket to %s", aurora.White(args[0]).Bold())
+		}
+	},
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import a bucket from an archive",
+	Long: `Creates a new remote bucket from an archive written by 'buck export'.
+
+The new bucket is created in a new thread unless '--to-thread' is provided.
+Archived access roles and metadata are restored, and you become an admin of the new bucket.
+Archived encryption keys are re-used unless the '--regenerate-keys' flag is provided.
+Use '-' to read the archive from stdin.
+Use 'buck init --thread <thread> --key <key>' to pull the new bucket.
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		name, err := c.Flags().GetString("name")
+		cmd.ErrCheck(err)
+		private, err := c.Flags().GetBool("private")
+		cmd.ErrCheck(err)
+		regenerate, err := c.Flags().GetBool("regenerate-keys")
+		cmd.ErrCheck(err)
+		var toThread thread.ID
+		ts, err := c.Flags().GetString("to-thread")
+		cmd.ErrCheck(err)
+		if len(ts) != 0 {
+			toThread, err = thread.Decode(ts)
+			cmd.ErrCheck(err)
+		}
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PushTimeout)
+		defer cancel()
+
+		var r io.Reader
+		if args[0] == "-" {
+			r = os.Stdin
+		} else {
+			file, err := os.Open(args[0])
+			cmd.ErrCheck(err)
+			defer file.Close()
+			r = file
+		}
+		bucket, links, err := bucks.Import(
+			ctx,
+			toThread,
+			conf.Identity,
+			r,
+			buckets.WithImportName(name),
+			buckets.WithImportPrivate(private),
+			buckets.WithImportRegenerateKeys(regenerate),
+		)
+		cmd.ErrCheck(err)
+		cmd.RenderTable([]string{"name", "thread", "key", "root"}, [][]string{{
+			bucket.Name,
+			bucket.Thread.String(),
+			bucket.Key,
+			bucket.Path,
+		}})
+		printLinks(links, DefaultFormat)
+		cmd.Success("Imported bucket %s", aurora.White(bucket.Key).Bold())
+	},
+}
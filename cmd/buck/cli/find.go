@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/cmd"
+)
+
+var findCmd = &cobra.Command{
+	Use: "find [path]",
+	Aliases: []string{
+		"search",
+	},
+	Short: "Find remote bucket objects",
+	Long: `Finds remote bucket objects under path that match all of the given filters.
+
+Name patterns use shell glob syntax, e.g., "*.jpg".
+Durations are relative to now, e.g., "--newer 24h" finds objects updated in the last day.
+Attributes are given as key=value pairs. Use "key=" to match any value.
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		query := buckets.SearchQuery{}
+		if len(args) > 0 {
+			query.Path = args[0]
+		}
+		var err error
+		query.Name, err = c.Flags().GetString("name")
+		cmd.ErrCheck(err)
+		typ, err := c.Flags().GetString("type")
+		cmd.ErrCheck(err)
+		switch typ {
+		case "":
+		case "f", "file":
+			query.Type = buckets.SearchFiles
+		case "d", "dir":
+			query.Type = buckets.SearchDirs
+		default:
+			cmd.Fatal(fmt.Errorf("type must be one of: f, d"))
+		}
+		query.MinSize, err = c.Flags().GetInt64("min-size")
+		cmd.ErrCheck(err)
+		query.MaxSize, err = c.Flags().GetInt64("max-size")
+		cmd.ErrCheck(err)
+		newer, err := c.Flags().GetDuration("newer")
+		cmd.ErrCheck(err)
+		if newer > 0 {
+			query.UpdatedAfter = time.Now().Add(-newer)
+		}
+		older, err := c.Flags().GetDuration("older")
+		cmd.ErrCheck(err)
+		if older > 0 {
+			query.UpdatedBefore = time.Now().Add(-older)
+		}
+		attrs, err := c.Flags().GetStringArray("attr")
+		cmd.ErrCheck(err)
+		if len(attrs) > 0 {
+			query.Attributes = make(map[string]string)
+			for _, a := range attrs {
+				parts := strings.SplitN(a, "=", 2)
+				if len(parts) != 2 || parts[0] == "" {
+					cmd.Fatal(fmt.Errorf("attributes must be in the form key=value"))
+				}
+				query.Attributes[parts[0]] = parts[1]
+			}
+		}
+		limit, err := c.Flags().GetInt("limit")
+		cmd.ErrCheck(err)
+
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+
+		var data [][]string
+		for {
+			items, cursor, err := buck.Search(ctx, query)
+			cmd.ErrCheck(err)
+			for _, item := range items {
+				data = append(data, []string{
+					bucketRelPath(item.Path),
+					formatBytes(item.Size, false),
+					strconv.FormatBool(item.IsDir),
+					item.Cid.String(),
+				})
+				if limit > 0 && len(data) == limit {
+					break
+				}
+			}
+			if cursor == "" || limit > 0 && len(data) == limit {
+				break
+			}
+			query.Cursor = cursor
+		}
+		if len(data) > 0 {
+			cmd.RenderTable([]string{"path", "size", "dir", "cid"}, data)
+		}
+		cmd.Message("Found %d objects", aurora.White(len(data)).Bold())
+	},
+}
+
+// bucketRelPath strips the root, e.g., "/ipfs/<cid>/", from a remote item path.
+func bucketRelPath(pth string) string {
+	parts := strings.SplitN(strings.TrimPrefix(pth, "/"), "/", 3)
+	if len(parts) < 3 {
+		return "/"
+	}
+	return parts[2]
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/cmd"
+	"github.com/textileio/go-threads/core/thread"
+)
+
+var forkCmd = &cobra.Command{
+	Use:   "fork",
+	Short: "Fork the bucket",
+	Long: `Creates a new remote bucket from the current remote bucket root.
+
+The new bucket is created in a new thread unless '--to-thread' is provided.
+Forks of private buckets are always private.
+Use the '--roles' flag to copy access roles from the bucket.
+Use 'buck init --thread <thread> --key <key>' to pull the new bucket.
+`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		name, err := c.Flags().GetString("name")
+		cmd.ErrCheck(err)
+		private, err := c.Flags().GetBool("private")
+		cmd.ErrCheck(err)
+		roles, err := c.Flags().GetBool("roles")
+		cmd.ErrCheck(err)
+		var toThread thread.ID
+		ts, err := c.Flags().GetString("to-thread")
+		cmd.ErrCheck(err)
+		if len(ts) != 0 {
+			toThread, err = thread.Decode(ts)
+			cmd.ErrCheck(err)
+		}
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PushTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		bucket, links, err := buck.Fork(
+			ctx,
+			toThread,
+			buckets.WithForkName(name),
+			buckets.WithForkPrivate(private),
+			buckets.WithForkRoles(roles),
+		)
+		cmd.ErrCheck(err)
+		cmd.RenderTable([]string{"name", "thread", "key", "root"}, [][]string{{
+			bucket.Name,
+			bucket.Thread.String(),
+			bucket.Key,
+			bucket.Path,
+		}})
+		printLinks(links, DefaultFormat)
+		cmd.Success("Forked bucket %s", aurora.White(bucket.Key).Bold())
+	},
+}
@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/cmd"
+	"github.com/textileio/go-threads/core/did"
+)
+
+var groupsCmd = &cobra.Command{
+	Use: "groups",
+	Aliases: []string{
+		"group",
+	},
+	Short: "Thread group management",
+	Long: `Manages named groups of identities in the bucket's thread.
+
+Groups can be granted access roles with "buck roles grant group:<name>".
+Only the group owner can manage members.`,
+	Args: cobra.ExactArgs(0),
+}
+
+var groupsCreateCmd = &cobra.Command{
+	Use:   "create [name] [members...]",
+	Short: "Create a group",
+	Long:  `Creates a named group of identities. Members must be multibase encoded public keys.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		group, err := buck.CreateGroup(ctx, args[0], toMembers(args[1:])...)
+		cmd.ErrCheck(err)
+		renderGroups(group)
+		cmd.Success("Created group %s", aurora.White(group.Name).Bold())
+	},
+}
+
+var groupsLsCmd = &cobra.Command{
+	Use: "ls",
+	Aliases: []string{
+		"list",
+	},
+	Short: "List groups",
+	Long:  `Lists groups in the bucket's thread.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		groups, err := buck.ListGroups(ctx)
+		cmd.ErrCheck(err)
+		renderGroups(groups...)
+		cmd.Message("Found %d groups", aurora.White(len(groups)).Bold())
+	},
+}
+
+var groupsAddCmd = &cobra.Command{
+	Use:   "add [name] [members...]",
+	Short: "Add group members",
+	Long:  `Adds members to a group. Members must be multibase encoded public keys.`,
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		group, err := buck.AddGroupMembers(ctx, args[0], toMembers(args[1:])...)
+		cmd.ErrCheck(err)
+		renderGroups(group)
+		cmd.Success("Added members to group %s", aurora.White(group.Name).Bold())
+	},
+}
+
+var groupsRemoveCmd = &cobra.Command{
+	Use: "remove [name] [members...]",
+	Aliases: []string{
+		"rm",
+	},
+	Short: "Remove group members",
+	Long:  `Removes members from a group.`,
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		group, err := buck.RemoveGroupMembers(ctx, args[0], toMembers(args[1:])...)
+		cmd.ErrCheck(err)
+		renderGroups(group)
+		cmd.Success("Removed members from group %s", aurora.White(group.Name).Bold())
+	},
+}
+
+var groupsDeleteCmd = &cobra.Command{
+	Use:   "delete [name]",
+	Short: "Delete a group",
+	Long:  `Deletes a group. Access roles granted to the group no longer apply to its former members.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		err = buck.DeleteGroup(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Deleted group %s", aurora.White(args[0]).Bold())
+	},
+}
+
+func toMembers(args []string) []did.DID {
+	members := make([]did.DID, len(args))
+	for i, a := range args {
+		members[i] = did.DID(a)
+	}
+	return members
+}
+
+func renderGroups(groups ...*buckets.Group) {
+	var data [][]string
+	for _, g := range groups {
+		members := make([]string, len(g.Members))
+		for i, m := range g.Members {
+			members[i] = string(m)
+		}
+		data = append(data, []string{g.Name, string(g.Owner), strconv.Itoa(len(members)), strings.Join(members, "\n")})
+	}
+	if len(data) > 0 {
+		cmd.RenderTable([]string{"name", "owner", "size", "members"}, data)
+	}
+}
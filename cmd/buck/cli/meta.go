@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets/cmd"
+)
+
+var metaCmd = &cobra.Command{
+	Use: "meta",
+	Aliases: []string{
+		"metadata",
+	},
+	Short: "Object metadata management",
+	Long:  `Manages remote bucket object metadata attributes.`,
+	Args:  cobra.ExactArgs(0),
+}
+
+var metaSetCmd = &cobra.Command{
+	Use:   "set [path] [key=value...]",
+	Short: "Set remote object metadata attributes",
+	Long: `Sets remote object metadata attributes, such as content-type, tags, or checksums.
+
+Attributes are merged with existing attributes. Use an empty value, e.g., "key=", to remove an attribute.
+Unlike access roles, attributes are not inherited by nested objects.
+`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(c *cobra.Command, args []string) {
+		attrs := make(map[string]string)
+		for _, a := range args[1:] {
+			parts := strings.SplitN(a, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				cmd.Fatal(fmt.Errorf("attributes must be in the form key=value"))
+			}
+			attrs[parts[0]] = parts[1]
+		}
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		res, err := buck.PushPathAttributes(ctx, args[0], attrs)
+		cmd.ErrCheck(err)
+		renderAttributes(res)
+		cmd.Success("Updated metadata attributes for path %s", aurora.White(args[0]).Bold())
+	},
+}
+
+var metaGetCmd = &cobra.Command{
+	Use:   "get [path] [key]",
+	Short: "Get remote object metadata attributes",
+	Long:  `Gets all remote object metadata attributes, or the value of a single attribute if key is given.`,
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		res, err := buck.PullPathAttributes(ctx, args[0])
+		cmd.ErrCheck(err)
+		if len(args) > 1 {
+			v, ok := res[args[1]]
+			if !ok {
+				cmd.Fatal(fmt.Errorf("attribute %s not found", args[1]))
+			}
+			fmt.Println(v)
+			return
+		}
+		renderAttributes(res)
+		cmd.Message("Found %d metadata attributes", aurora.White(len(res)).Bold())
+	},
+}
+
+func renderAttributes(attrs map[string]string) {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var data [][]string
+	for _, k := range keys {
+		data = append(data, []string{k, attrs[k]})
+	}
+	if len(data) > 0 {
+		cmd.RenderTable([]string{"key", "value"}, data)
+	}
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets/cmd"
+)
+
+var privacyCmd = &cobra.Command{
+	Use:   "privacy [public|private]",
+	Short: "Show or change bucket privacy",
+	Long: `Shows whether the remote bucket is public or private, or converts it in place.
+
+Making a bucket private encrypts all files and folders with new keys and removes public read access.
+Making a bucket public decrypts all files and folders and grants public read access.
+Only the bucket owner can change privacy.
+Previous bucket versions are unpinned and can no longer be restored.
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		yes, err := c.Flags().GetBool("yes")
+		cmd.ErrCheck(err)
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PushTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		if len(args) == 0 {
+			info, err := buck.Get(ctx)
+			cmd.ErrCheck(err)
+			if info.IsPrivate() {
+				cmd.Message("%s", aurora.White("private").Bold())
+			} else {
+				cmd.Message("%s", aurora.White("public").Bold())
+			}
+			return
+		}
+
+		var private bool
+		switch args[0] {
+		case "private":
+			private = true
+		case "public":
+		default:
+			cmd.Fatal(fmt.Errorf("invalid privacy: %s", args[0]))
+		}
+		if !yes {
+			cmd.Warn("%s", aurora.Red("Previous bucket versions will no longer be restorable."))
+			prompt := promptui.Prompt{
+				Label:     fmt.Sprintf("Make bucket %s", args[0]),
+				IsConfirm: true,
+			}
+			if _, err = prompt.Run(); err != nil {
+				cmd.End("")
+			}
+		}
+		root, err := buck.SetPrivacy(ctx, private)
+		cmd.ErrCheck(err)
+		cmd.Message("%s", aurora.White(root.Cid()).Bold())
+		cmd.Success("Bucket is now %s", args[0])
+	},
+}
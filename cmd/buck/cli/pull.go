@@ -15,6 +15,7 @@ var pullCmd = &cobra.Command{
 	Long: `Pulls paths that have been added to and paths that have been removed or differ from the remote bucket root.
 
 Use the '--hard' flag to discard all local changes.
+Use the '--merge' flag to merge local and remote changes, resolving conflicting paths interactively.
 Use the '--force' flag to pull all remote objects, even if they already exist locally.
 `,
 	Args: cobra.ExactArgs(0),
@@ -23,6 +24,8 @@ Use the '--force' flag to pull all remote objects, even if they already exist lo
 		cmd.ErrCheck(err)
 		hard, err := c.Flags().GetBool("hard")
 		cmd.ErrCheck(err)
+		merge, err := c.Flags().GetBool("merge")
+		cmd.ErrCheck(err)
 		yes, err := c.Flags().GetBool("yes")
 		cmd.ErrCheck(err)
 		quiet, err := c.Flags().GetBool("quiet")
@@ -44,7 +47,10 @@ Use the '--force' flag to pull all remote objects, even if they already exist lo
 			local.WithConfirm(getConfirm("Discard %d local changes", yes)),
 			local.WithForce(force),
 			local.WithHard(hard),
+			local.WithMerge(merge),
+			local.WithResolveConflict(getResolveConflict(yes)),
 			local.WithEvents(events))
+		handleConflicts(err)
 		if errors.Is(err, local.ErrAborted) {
 			cmd.End("")
 		} else if errors.Is(err, local.ErrUpToDate) {
@@ -11,7 +11,7 @@ import (
 	"github.com/textileio/go-buckets/local"
 )
 
-const nonFastForwardMsg = "the root of your bucket is behind (try `%s` before pushing again)"
+const nonFastForwardMsg = "the root of your bucket is behind (try `%s` or `%s` before pushing again)"
 
 var pushCmd = &cobra.Command{
 	Use:   "push",
@@ -19,11 +19,14 @@ var pushCmd = &cobra.Command{
 	Long: `Pushes paths that have been added to and paths that have been removed or differ from the local bucket root.
 
 Use the '--force' flag to allow a non-fast-forward update.
+Use the '--merge' flag to merge remote changes before pushing, resolving conflicting paths interactively.
 `,
 	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
 		force, err := c.Flags().GetBool("force")
 		cmd.ErrCheck(err)
+		merge, err := c.Flags().GetBool("merge")
+		cmd.ErrCheck(err)
 		yes, err := c.Flags().GetBool("yes")
 		cmd.ErrCheck(err)
 		quiet, err := c.Flags().GetBool("quiet")
@@ -45,14 +48,17 @@ Use the '--force' flag to allow a non-fast-forward update.
 			ctx,
 			local.WithConfirm(getConfirm("Push %d changes", yes)),
 			local.WithForce(force),
+			local.WithMerge(merge),
+			local.WithResolveConflict(getResolveConflict(yes)),
 			local.WithEvents(events),
 		)
+		handleConflicts(err)
 		if errors.Is(err, local.ErrAborted) {
 			cmd.End("")
 		} else if errors.Is(err, local.ErrUpToDate) {
 			cmd.End("Everything up-to-date")
 		} else if err != nil && strings.Contains(err.Error(), buckets.ErrNonFastForward.Error()) {
-			cmd.Fatal(errors.New(nonFastForwardMsg), aurora.Cyan("buck pull"))
+			cmd.Fatal(errors.New(nonFastForwardMsg), aurora.Cyan("buck pull"), aurora.Cyan("buck push --merge"))
 		} else if err != nil {
 			cmd.Fatal(err)
 		}
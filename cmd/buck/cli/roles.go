@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
@@ -27,6 +28,7 @@ var rolesGrantCmd = &cobra.Command{
 	Long: `Grants remote object access roles to an identity.
 
 Identity must be a multibase encoded public key. A "*" value will set the default access role for an object.
+A "group:<name>" value grants the role to all members of a thread group (see "buck groups").
 
 Access roles:
 "none": Revokes all access.
@@ -65,6 +67,10 @@ Access roles:
 		if err != nil {
 			cmd.Err(fmt.Errorf("access role must be one of: none, reader, writer, or admin"))
 		}
+		if name, ok := collection.GroupFromPrincipal(did.DID(args[0])); ok {
+			err = collection.ValidateGroupName(name)
+			cmd.ErrCheck(err)
+		}
 		var pth string
 		if len(args) > 1 {
 			pth = args[1]
@@ -119,3 +125,76 @@ var rolesLsCmd = &cobra.Command{
 		cmd.Message("Found %d access roles", aurora.White(len(data)).Bold())
 	},
 }
+
+var rolesExplainCmd = &cobra.Command{
+	Use:   "explain [identity] [path]",
+	Short: "Explain an identity's effective access role",
+	Long: `Explains the effective access role of an identity at a remote bucket path.
+
+Roles granted at a path apply to all of its descendants, and the most privileged role wins.
+Roles may be granted to the identity directly, to "*", or to a group the identity is a member of.
+A role granted to "*" never confers more than write access.
+
+Use the '--tree' flag to also list the roles of every object with roles in the bucket.
+`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(c *cobra.Command, args []string) {
+		tree, err := c.Flags().GetBool("tree")
+		cmd.ErrCheck(err)
+		format, err := c.Flags().GetString("format")
+		cmd.ErrCheck(err)
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		var pth string
+		if len(args) > 1 {
+			pth = args[1]
+		}
+		report, err := buck.EffectiveAccess(ctx, pth, did.DID(args[0]))
+		cmd.ErrCheck(err)
+
+		if Format(format) == JSONFormat {
+			cmd.RenderJSON(report)
+			return
+		}
+		var data [][]string
+		for _, g := range report.Grants {
+			data = append(data, []string{displayPath(g.Path), string(g.Principal), g.Role.String()})
+		}
+		if len(data) > 0 {
+			cmd.RenderTable([]string{"path", "granted to", "role"}, data)
+		}
+		if tree {
+			var tdata [][]string
+			for _, e := range report.Tree {
+				for p, r := range e.Roles {
+					tdata = append(tdata, []string{displayPath(e.Path), string(p), r.String()})
+				}
+			}
+			sort.SliceStable(tdata, func(i, j int) bool {
+				if tdata[i][0] != tdata[j][0] {
+					return tdata[i][0] < tdata[j][0]
+				}
+				return tdata[i][1] < tdata[j][1]
+			})
+			if len(tdata) > 0 {
+				cmd.RenderTable([]string{"path", "identity", "role"}, tdata)
+			}
+		}
+		if report.Owner {
+			cmd.Message("Identity is the bucket owner")
+		}
+		if report.Role == collection.NoneRole {
+			cmd.Message("Identity has no access to %s", aurora.White(displayPath(report.Path)).Bold())
+			return
+		}
+		cmd.Message("Identity has role %s at %s, granted to %s at %s",
+			aurora.White(report.Role).Bold(),
+			aurora.White(displayPath(report.Path)).Bold(),
+			aurora.White(report.Principal).Bold(),
+			aurora.White(displayPath(report.From)).Bold())
+	},
+}
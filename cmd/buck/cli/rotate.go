@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/cmd"
+)
+
+var rotateKeysCmd = &cobra.Command{
+	Use:   "rotate-keys",
+	Short: "Rotate private bucket encryption keys",
+	Long: `Re-encrypts the remote private bucket with a new link key.
+
+Use the '--file-keys' flag to also replace every file encryption key.
+Only the bucket owner can rotate keys.
+Previous bucket versions are unpinned and can no longer be restored.
+`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		fileKeys, err := c.Flags().GetBool("file-keys")
+		cmd.ErrCheck(err)
+		yes, err := c.Flags().GetBool("yes")
+		cmd.ErrCheck(err)
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PushTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		if !yes {
+			cmd.Warn("%s", aurora.Red("Previous bucket versions will no longer be restorable."))
+			prompt := promptui.Prompt{
+				Label:     "Rotate keys",
+				IsConfirm: true,
+			}
+			if _, err = prompt.Run(); err != nil {
+				cmd.End("")
+			}
+		}
+		root, err := buck.RotateKeys(ctx, buckets.WithRotateFileKeys(fileKeys))
+		cmd.ErrCheck(err)
+		cmd.Message("%s", aurora.White(root.Cid()).Bold())
+		cmd.Success("Rotated bucket keys")
+	},
+}
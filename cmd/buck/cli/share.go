@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets/cmd"
+	"github.com/textileio/go-buckets/share"
+)
+
+var shareCmd = &cobra.Command{
+	Use:   "share [path]",
+	Short: "Create an expiring share link",
+	Long: `Creates a read-only gateway link to a remote bucket path that expires.
+
+Share links can be used in place of the identity token query param, so private files can be shared
+without exposing your identity. Descendants of a shared folder are also shared.
+Links never outlive the identity token used to create them.
+Use the '--max-downloads' flag to limit the number of file downloads.
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		expires, err := c.Flags().GetDuration("expires")
+		cmd.ErrCheck(err)
+		maxDownloads, err := c.Flags().GetInt("max-downloads")
+		cmd.ErrCheck(err)
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		var pth string
+		if len(args) > 0 {
+			pth = args[0]
+		}
+		link, err := buck.CreateShareLink(ctx, pth, share.WithTTL(expires), share.WithMaxDownloads(maxDownloads))
+		cmd.ErrCheck(err)
+		cmd.Message("%s", aurora.White(link.Url).Bold())
+		cmd.Success("Created share link %s, expires %s", aurora.White(link.Id).Bold(), formatShareTime(link.ExpiresAt))
+	},
+}
+
+var shareLsCmd = &cobra.Command{
+	Use: "ls",
+	Aliases: []string{
+		"list",
+	},
+	Short: "List share links",
+	Long:  `Lists share links created with the bucket identity, including expired links.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		links, err := buck.ListShareLinks(ctx)
+		cmd.ErrCheck(err)
+		var data [][]string
+		for _, l := range links {
+			downloads := strconv.Itoa(int(l.Downloads))
+			if l.MaxDownloads > 0 {
+				downloads += "/" + strconv.Itoa(int(l.MaxDownloads))
+			}
+			data = append(data, []string{l.Id, "/" + l.Path, formatShareTime(l.ExpiresAt), downloads})
+		}
+		if len(data) > 0 {
+			cmd.RenderTable([]string{"id", "path", "expires", "downloads"}, data)
+		}
+		cmd.Message("Found %d share links", aurora.White(len(data)).Bold())
+	},
+}
+
+var shareRevokeCmd = &cobra.Command{
+	Use:   "revoke [id]",
+	Short: "Revoke a share link",
+	Long:  `Revokes a share link. The link can no longer be used with the gateway.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		err = buck.RevokeShareLink(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Revoked share link %s", aurora.White(args[0]).Bold())
+	},
+}
+
+func formatShareTime(t int64) string {
+	exp := time.Unix(0, t)
+	if !time.Now().Before(exp) {
+		return "expired"
+	}
+	return exp.Format(time.RFC822)
+}
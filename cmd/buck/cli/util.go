@@ -1,9 +1,11 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"runtime"
+	"strings"
 
 	pb "github.com/cheggaaa/pb/v3"
 	"github.com/manifoldco/promptui"
@@ -31,6 +33,39 @@ func getConfirm(label string, auto bool) local.ConfirmDiffFunc {
 	}
 }
 
+func getResolveConflict(auto bool) local.ResolveConflictFunc {
+	if auto {
+		return nil // Conflicts will be reported
+	}
+	return func(c local.Conflict) (s local.ConflictStrategy, err error) {
+		prompt := promptui.Select{
+			Label: fmt.Sprintf(
+				"Conflict in %s (local %s remote %s)",
+				c.Rel,
+				strings.TrimSuffix(local.ChangeType(c.Local), ":"),
+				strings.TrimSuffix(local.ChangeType(c.Remote), ":"),
+			),
+			Items: []local.ConflictStrategy{local.KeepLocal, local.KeepRemote},
+		}
+		_, res, err := prompt.Run()
+		if err != nil {
+			return s, err
+		}
+		return local.ConflictStrategy(res), nil
+	}
+}
+
+func handleConflicts(err error) {
+	var cerr *local.ConflictError
+	if !errors.As(err, &cerr) {
+		return
+	}
+	for _, c := range cerr.Conflicts {
+		cmd.Message("%s  %s", aurora.Magenta("conflict:"), aurora.Magenta(c.Rel))
+	}
+	cmd.Fatal(errors.New("%d paths changed locally and remotely (resolve conflicts interactively without '--yes')"), len(cerr.Conflicts))
+}
+
 func handleEvents(events chan local.Event) {
 	var bar *pb.ProgressBar
 	if runtime.GOOS != "windows" {
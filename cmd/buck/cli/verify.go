@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets/cmd"
+)
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify bucket integrity",
+	Long: `Verifies that the remote bucket's content is intact.
+
+Every block in the remote bucket is retrieved and checked against its hash.
+Private bucket folders and files are also checked to decrypt with their keys.
+Remote files are then compared with the local bucket, which differ if the local bucket is not up-to-date.
+
+Exits with a non-zero status if verification fails.
+`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		format, err := c.Flags().GetString("format")
+		cmd.ErrCheck(err)
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		report, err := buck.Verify(ctx)
+		cmd.ErrCheck(err)
+
+		if Format(format) == JSONFormat {
+			cmd.RenderJSON(report)
+		} else {
+			var data [][]string
+			for _, e := range report.Errors {
+				data = append(data, []string{displayPath(e.Path), e.Cid.String(), "remote", e.Reason})
+			}
+			for _, e := range report.Local {
+				data = append(data, []string{displayPath(e.Path), e.Cid.String(), "local", e.Reason})
+			}
+			if len(data) > 0 {
+				cmd.RenderTable([]string{"path", "cid", "check", "reason"}, data)
+			}
+			if len(report.Skipped) > 0 {
+				cmd.Warn("Skipped decrypting %d files that are not readable", len(report.Skipped))
+			}
+			cmd.Message("Checked %d blocks and %d objects at %s",
+				aurora.White(report.Blocks).Bold(),
+				aurora.White(len(report.Items)).Bold(),
+				aurora.White(report.Root).Bold())
+		}
+
+		switch {
+		case !report.VerifyReport.OK():
+			cmd.Fatal(errors.New("bucket verification failed"))
+		case Format(format) == JSONFormat:
+		case !report.OK():
+			cmd.Warn("Local bucket differs from the remote. Use 'buck pull' to update it.")
+		default:
+			cmd.Success("Bucket is intact")
+		}
+	},
+}
+
+func displayPath(pth string) string {
+	if pth == "" {
+		return "/"
+	}
+	return pth
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets/cmd"
+	"github.com/textileio/go-buckets/local"
+)
+
+var logCmd = &cobra.Command{
+	Use:   "log",
+	Short: "List bucket versions",
+	Long: `Lists the remote bucket's root history, most recent first.
+
+Only pinned versions can be restored. See 'buck restore'.
+`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		versions, err := buck.ListVersions(ctx)
+		cmd.ErrCheck(err)
+		var data [][]string
+		for _, v := range versions {
+			data = append(data, []string{
+				strings.TrimPrefix(v.Path, "/ipfs/"),
+				string(v.Author),
+				strconv.FormatBool(v.Pinned),
+				time.Unix(0, v.CreatedAt).Format(time.RFC3339),
+			})
+		}
+		if len(data) > 0 {
+			cmd.RenderTable([]string{"root", "author", "pinned", "date"}, data)
+		}
+		cmd.Message("Found %d versions", aurora.White(len(data)).Bold())
+	},
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore [version]",
+	Short: "Restore a bucket version",
+	Long: `Restores the remote bucket root to a pinned version from the bucket's history and pulls the changes.
+
+The version can be any root cid listed by 'buck log'.
+Use the '--hard' flag to discard all local changes.
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		hard, err := c.Flags().GetBool("hard")
+		cmd.ErrCheck(err)
+		yes, err := c.Flags().GetBool("yes")
+		cmd.ErrCheck(err)
+		quiet, err := c.Flags().GetBool("quiet")
+		cmd.ErrCheck(err)
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		_, err = buck.RestoreVersion(ctx, args[0])
+		cmd.ErrCheck(err)
+		var events chan local.Event
+		if !quiet {
+			events = make(chan local.Event)
+			defer close(events)
+			go handleEvents(events)
+		}
+		roots, err := buck.PullRemote(
+			ctx,
+			local.WithConfirm(getConfirm("Discard %d local changes", yes)),
+			local.WithHard(hard),
+			local.WithEvents(events))
+		if errors.Is(err, local.ErrAborted) {
+			cmd.End("")
+		} else if errors.Is(err, local.ErrUpToDate) {
+			cmd.End("Everything up-to-date")
+		} else if err != nil {
+			cmd.Fatal(err)
+		}
+		cmd.Message("%s", aurora.White(roots.Remote).Bold())
+	},
+}
@@ -1,45 +1,52 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
 
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets/cmd"
 	"github.com/textileio/go-buckets/local"
 )
 
-//var watchCmd = &cobra.Command{
-//	Use:   "watch",
-//	Short: "Watch auto-pushes local changes to the remote",
-//	Long:  `Watch auto-pushes local changes to the remote.`,
-//	Args:  cobra.ExactArgs(0),
-//	Run: func(c *cobra.Command, args []string) {
-//		conf, err := bucks.NewConfigFromCmd(c, ".")
-//		cmd.ErrCheck(err)
-//		ctx, cancel := context.WithCancel(context.Background())
-//		defer cancel()
-//		buck, err := bucks.GetLocalBucket(ctx, conf)
-//		cmd.ErrCheck(err)
-//		bp, err := buck.Path()
-//		cmd.ErrCheck(err)
-//		events := make(chan local.Event)
-//		defer close(events)
-//		go handleWatchEvents(events)
-//		state, err := buck.Watch(ctx, local.WithWatchEvents(events), local.WithOffline(true))
-//		cmd.ErrCheck(err)
-//		for s := range state {
-//			switch s.State {
-//			case cmd.Online:
-//				cmd.Success("Watching %s for changes...", aurora.White(bp).Bold())
-//			case cmd.Offline:
-//				if s.Aborted {
-//					cmd.Fatal(s.Err)
-//				} else {
-//					cmd.Message("Not connected. Trying to connect...")
-//				}
-//			}
-//		}
-//	},
-//}
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Watch auto-pushes local changes to the remote",
+	Long: `Watch auto-pushes local changes to the remote and auto-pulls remote changes as they happen.
+
+Local changes are pushed as they are detected. Remote changes are pulled as they arrive.
+If the remote has moved ahead of a local push, remote changes are pulled first.
+Watch keeps running while the network is offline, reconnecting when it comes back.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		bp, err := buck.Path()
+		cmd.ErrCheck(err)
+		events := make(chan local.Event)
+		defer close(events)
+		go handleWatchEvents(events)
+		state, err := buck.Watch(ctx, local.WithWatchEvents(events), local.WithOffline(true))
+		cmd.ErrCheck(err)
+		for s := range state {
+			switch s.State {
+			case cmd.Online:
+				cmd.Success("Watching %s for changes...", aurora.White(bp).Bold())
+			case cmd.Offline:
+				if s.Aborted {
+					cmd.Fatal(s.Err)
+				} else {
+					cmd.Message("Not connected. Trying to connect...")
+				}
+			}
+		}
+	},
+}
 
 func handleWatchEvents(events chan local.Event) {
 	for e := range events {
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	httpapi "github.com/ipfs/go-ipfs-http-client"
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/cmd"
+	dbc "github.com/textileio/go-threads/api/client"
+	nc "github.com/textileio/go-threads/net/api/client"
+)
+
+func init() {
+	rootCmd.AddCommand(fsckCmd)
+	fsckCmd.Flags().Bool("fix", false, "Pin missing data and unpin orphaned data")
+}
+
+var fsckCmd = &cobra.Command{
+	Use:     "fsck",
+	Aliases: []string{"gc"},
+	Short:   "Check and repair bucket pins",
+	Long: `Compares the pins expected by all buckets with the pins held by the pinning backend.
+
+Pinning and bucket updates are not transactional, so an interrupted update can leave data
+unpinned (missing) or pinned without belonging to any bucket (orphaned).
+
+By default, discrepancies are only reported. Use --fix to repair them.
+Only pins created by the daemon are checked, so the pinning backend can be shared.
+Orphaned pins are only removed if every bucket could be fully checked.
+Pins held by in-progress uploads are reported as orphaned, so only use --fix while the daemon is stopped.
+Pins created before pins were recorded are adopted on first start unless --pinningIpfsSeed=false.
+The badger datastore can't be opened while the daemon is running.`,
+	Args: cobra.NoArgs,
+	Run: func(c *cobra.Command, args []string) {
+		fix, err := c.Flags().GetBool("fix")
+		cmd.ErrCheck(err)
+
+		threadsApi := config.Viper.GetString("threads.addr")
+		ipfsApi := cmd.AddrFromStr(config.Viper.GetString("ipfs.multiaddr"))
+
+		net, err := nc.NewClient(threadsApi, getClientRPCOpts(threadsApi)...)
+		cmd.ErrCheck(err)
+		defer net.Close()
+		db, err := dbc.NewClient(threadsApi, getClientRPCOpts(threadsApi)...)
+		cmd.ErrCheck(err)
+		defer db.Close()
+		ipfs, err := httpapi.NewApi(ipfsApi)
+		cmd.ErrCheck(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
+		defer cancel()
+
+		var pinningms, serverms ds.TxnDatastore
+		switch config.Viper.GetString("datastore.type") {
+		case "badger":
+			pinningms, err = newBadgerStore(config.Viper.GetString("datastore.badger.repo"))
+			cmd.ErrCheck(err)
+			serverms = pinningms
+		case "mongo":
+			uri := config.Viper.GetString("datastore.mongo.uri")
+			name := config.Viper.GetString("datastore.mongo.name")
+			pinningms, err = newMongoStore(ctx, uri, name, "pinning")
+			cmd.ErrCheck(err)
+			serverms, err = newMongoStore(ctx, uri, name, "server")
+			cmd.ErrCheck(err)
+			defer serverms.Close()
+		default:
+			cmd.Fatal(errors.New("datastoreType must be 'badger' or 'mongo'"))
+		}
+		defer pinningms.Close()
+		pinner, err := newPinner(ipfs, pinningms)
+		cmd.ErrCheck(err)
+		server, err := loadServerIdentity(serverms)
+		cmd.ErrCheck(err)
+
+		lib, err := buckets.NewBuckets(net, db, ipfs, pinner, nil, nil, nil, buckets.WithServerIdentity(server))
+		cmd.ErrCheck(err)
+		defer lib.Close()
+
+		report, err := lib.Fsck(ctx, buckets.WithFsckFix(fix))
+		cmd.ErrCheck(err)
+
+		if len(report.Incomplete) > 0 {
+			data := make([][]string, len(report.Incomplete))
+			for i, b := range report.Incomplete {
+				data[i] = []string{b.Thread.String(), b.Key, b.Reason}
+			}
+			cmd.RenderTable([]string{"thread", "key", "reason"}, data)
+		}
+		renderCids("missing", report.Missing, report.Pinned)
+		renderCids("orphaned", report.Orphaned, report.Unpinned)
+		for _, e := range report.Errors {
+			cmd.Err(errors.New(e))
+		}
+
+		cmd.Message("Checked %d buckets and %d pins", report.Buckets, report.Pins)
+		if len(report.Incomplete) > 0 {
+			cmd.Warn("%d buckets could not be fully checked", len(report.Incomplete))
+		}
+		switch {
+		case len(report.Missing) == 0 && len(report.Orphaned) == 0:
+			cmd.Success("No discrepancies found")
+		case !fix:
+			cmd.Message("Found %d missing and %d orphaned pins. Use --fix to repair.",
+				len(report.Missing), len(report.Orphaned))
+		default:
+			cmd.Message("Pinned %d of %d missing and unpinned %d of %d orphaned",
+				len(report.Pinned), len(report.Missing), len(report.Unpinned), len(report.Orphaned))
+			if len(report.Orphaned) > 0 && len(report.Incomplete) > 0 {
+				cmd.Warn("Orphaned pins were not removed because some buckets could not be fully checked")
+			}
+		}
+	},
+}
+
+// renderCids renders a table of cids with their repair status.
+func renderCids(kind string, cids, repaired []cid.Cid) {
+	if len(cids) == 0 {
+		return
+	}
+	done := make(map[cid.Cid]struct{}, len(repaired))
+	for _, r := range repaired {
+		done[r] = struct{}{}
+	}
+	data := make([][]string, len(cids))
+	for i, id := range cids {
+		_, ok := done[id]
+		data[i] = []string{id.String(), kind, yesNo(ok)}
+	}
+	cmd.RenderTable([]string{"cid", "status", "repaired"}, data)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
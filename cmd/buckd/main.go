@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/tls"
 	"encoding/json"
 	"errors"
@@ -14,17 +15,24 @@ import (
 	badger "github.com/ipfs/go-ds-badger"
 	httpapi "github.com/ipfs/go-ipfs-http-client"
 	logging "github.com/ipfs/go-log/v2"
+	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/textileio/go-buckets"
 	"github.com/textileio/go-buckets/api/common"
+	"github.com/textileio/go-buckets/audit"
 	"github.com/textileio/go-buckets/cmd"
 	dns "github.com/textileio/go-buckets/dns"
 	"github.com/textileio/go-buckets/gateway"
 	ipns "github.com/textileio/go-buckets/ipns"
+	"github.com/textileio/go-buckets/pinning"
+	"github.com/textileio/go-buckets/quota"
+	"github.com/textileio/go-buckets/share"
 	mongods "github.com/textileio/go-ds-mongo"
 	dbc "github.com/textileio/go-threads/api/client"
 	"github.com/textileio/go-threads/core/did"
+	"github.com/textileio/go-threads/core/thread"
 	nc "github.com/textileio/go-threads/net/api/client"
 	"github.com/textileio/go-threads/util"
 	"google.golang.org/grpc"
@@ -108,6 +116,28 @@ var (
 				DefValue: "/ip4/127.0.0.1/tcp/5001",
 			},
 
+			// Pinning
+			"pinningBackend": {
+				Key:      "pinning.backend",
+				DefValue: "ipfs",
+			},
+			"pinningRemoteEndpoint": {
+				Key:      "pinning.remote.endpoint",
+				DefValue: "",
+			},
+			"pinningRemoteToken": {
+				Key:      "pinning.remote.token",
+				DefValue: "",
+			},
+			"pinningRemoteOrigins": {
+				Key:      "pinning.remote.origins",
+				DefValue: []string{},
+			},
+			"pinningIpfsSeed": {
+				Key:      "pinning.ipfs.seed",
+				DefValue: true,
+			},
+
 			// IPNS
 			"ipnsRepublishSchedule": {
 				Key:      "ipns.republish_schedule",
@@ -118,6 +148,22 @@ var (
 				DefValue: 100,
 			},
 
+			// Versions
+			"versionsPinned": {
+				Key:      "versions.pinned",
+				DefValue: buckets.DefaultPinnedVersions,
+			},
+
+			// Quota
+			"quotaDefaultLimit": {
+				Key:      "quota.default_limit",
+				DefValue: int64(0),
+			},
+			"quotaAdmins": {
+				Key:      "quota.admins",
+				DefValue: []string{},
+			},
+
 			// Cloudflare
 			"cloudflareDnsZoneID": {
 				Key:      "cloudflare.dns.zone_id",
@@ -211,6 +257,28 @@ func init() {
 		config.Flags["ipfsMultiaddr"].DefValue.(string),
 		"IPFS API multiaddress")
 
+	// Pinning
+	rootCmd.PersistentFlags().String(
+		"pinningBackend",
+		config.Flags["pinningBackend"].DefValue.(string),
+		"Pinning backend (ipfs/remote)")
+	rootCmd.PersistentFlags().String(
+		"pinningRemoteEndpoint",
+		config.Flags["pinningRemoteEndpoint"].DefValue.(string),
+		"IPFS Pinning Service API endpoint")
+	rootCmd.PersistentFlags().String(
+		"pinningRemoteToken",
+		config.Flags["pinningRemoteToken"].DefValue.(string),
+		"IPFS Pinning Service API access token")
+	rootCmd.PersistentFlags().StringSlice(
+		"pinningRemoteOrigins",
+		config.Flags["pinningRemoteOrigins"].DefValue.([]string),
+		"Multiaddresses of the IPFS node, which the pinning service can fetch data from")
+	rootCmd.PersistentFlags().Bool(
+		"pinningIpfsSeed",
+		config.Flags["pinningIpfsSeed"].DefValue.(bool),
+		"Adopt existing IPFS pins if none have been recorded (disable if the IPFS node is shared)")
+
 	// IPNS
 	rootCmd.PersistentFlags().String(
 		"ipnsRepublishSchedule",
@@ -221,6 +289,22 @@ func init() {
 		config.Flags["ipnsRepublishConcurrency"].DefValue.(int),
 		"IPNS republishing batch size")
 
+	// Versions
+	rootCmd.PersistentFlags().Int(
+		"versionsPinned",
+		config.Flags["versionsPinned"].DefValue.(int),
+		"Number of replaced bucket roots that remain pinned and restorable")
+
+	// Quota
+	rootCmd.PersistentFlags().Int64(
+		"quotaDefaultLimit",
+		config.Flags["quotaDefaultLimit"].DefValue.(int64),
+		"Default storage limit in bytes per identity (0 for no limit)")
+	rootCmd.PersistentFlags().StringSlice(
+		"quotaAdmins",
+		config.Flags["quotaAdmins"].DefValue.([]string),
+		"Identity DIDs allowed to get and set storage usage")
+
 	// Cloudflare
 	rootCmd.PersistentFlags().String(
 		"cloudflareDnsZoneID",
@@ -254,7 +338,11 @@ var rootCmd = &cobra.Command{
 				"buckets-api":     logging.LevelDebug,
 				"buckets-gateway": logging.LevelDebug,
 				"buckets-ipns":    logging.LevelDebug,
+				"buckets-pinning": logging.LevelDebug,
 				"buckets-dns":     logging.LevelDebug,
+				"buckets-quota":   logging.LevelDebug,
+				"buckets-share":   logging.LevelDebug,
+				"buckets-audit":   logging.LevelDebug,
 			})
 			cmd.ErrCheck(err)
 		}
@@ -289,6 +377,11 @@ var rootCmd = &cobra.Command{
 		//ipnsRepublishSchedule := config.Viper.GetString("ipns.republish_schedule")
 		//ipnsRepublishConcurrency := config.Viper.GetInt("ipns.republish_concurrency")
 
+		versionsPinned := config.Viper.GetInt("versions.pinned")
+
+		quotaDefaultLimit := config.Viper.GetInt64("quota.default_limit")
+		quotaAdmins := config.Viper.GetStringSlice("quota.admins")
+
 		cloudflareDnsZoneID := config.Viper.GetString("cloudflare.dns.zone_id")
 		cloudflareDnsToken := config.Viper.GetString("cloudflare.dns.token")
 
@@ -299,19 +392,41 @@ var rootCmd = &cobra.Command{
 		ipfs, err := httpapi.NewApi(ipfsApi)
 		cmd.ErrCheck(err)
 
-		var ipnsms ds.TxnDatastore
+		var ipnsms, quotams, sharems, auditms, pinningms, uploadsms, serverms ds.TxnDatastore
 		switch datastoreType {
 		case "badger":
 			ipnsms, err = newBadgerStore(datastoreBadgerRepo)
 			cmd.ErrCheck(err)
+			quotams = ipnsms
+			sharems = ipnsms
+			auditms = ipnsms
+			pinningms = ipnsms
+			uploadsms = ipnsms
+			serverms = ipnsms
 		case "mongo":
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 			ipnsms, err = newMongoStore(ctx, datastoreMongoUri, datastoreMongoName, "ipns")
 			cmd.ErrCheck(err)
+			quotams, err = newMongoStore(ctx, datastoreMongoUri, datastoreMongoName, "quota")
+			cmd.ErrCheck(err)
+			sharems, err = newMongoStore(ctx, datastoreMongoUri, datastoreMongoName, "share")
+			cmd.ErrCheck(err)
+			auditms, err = newMongoStore(ctx, datastoreMongoUri, datastoreMongoName, "audit")
+			cmd.ErrCheck(err)
+			pinningms, err = newMongoStore(ctx, datastoreMongoUri, datastoreMongoName, "pinning")
+			cmd.ErrCheck(err)
+			uploadsms, err = newMongoStore(ctx, datastoreMongoUri, datastoreMongoName, "uploads")
+			cmd.ErrCheck(err)
+			serverms, err = newMongoStore(ctx, datastoreMongoUri, datastoreMongoName, "server")
+			cmd.ErrCheck(err)
 		default:
 			cmd.Fatal(errors.New("datastoreType must be 'badger' or 'mongo'"))
 		}
+		pinner, err := newPinner(ipfs, pinningms)
+		cmd.ErrCheck(err)
+		server, err := loadServerIdentity(serverms)
+		cmd.ErrCheck(err)
 		ipnsm, err := ipns.NewManager(ipnsms, ipfs)
 		cmd.ErrCheck(err)
 
@@ -323,13 +438,39 @@ var rootCmd = &cobra.Command{
 			cmd.Fatal(errors.New("cloudflareDnsZoneID or cloudflareDnsToken not specified"))
 		}
 
-		lib, err := buckets.NewBuckets(net, db, ipfs, ipnsm, dnsm)
+		lib, err := buckets.NewBuckets(
+			net,
+			db,
+			ipfs,
+			pinner,
+			ipnsm,
+			dnsm,
+			audit.NewLog(auditms),
+			buckets.WithPinnedVersions(versionsPinned),
+			buckets.WithUploadSessionStore(uploadsms),
+			buckets.WithServerIdentity(server),
+		)
 		cmd.ErrCheck(err)
 
 		buckets.GatewayURL = gatewayUrl
 		buckets.WWWDomain = gatewayWwwDomain
 
-		server, proxy, err := common.GetServerAndProxy(lib, addrApi, addrApiProxy)
+		ledger := quota.NewLedger(quotams, quota.Config{
+			DefaultLimit: quotaDefaultLimit,
+		})
+		admins := make([]did.DID, len(quotaAdmins))
+		for i, a := range quotaAdmins {
+			admins[i] = did.DID(a)
+		}
+		shares := share.NewStore(sharems)
+
+		server, proxy, err := common.GetServerAndProxy(
+			lib,
+			addrApi,
+			addrApiProxy,
+			common.WithQuota(ledger, admins...),
+			common.WithShares(shares),
+		)
 		cmd.ErrCheck(err)
 
 		// Configure gateway
@@ -338,6 +479,7 @@ var rootCmd = &cobra.Command{
 			URL:        gatewayUrl,
 			Domain:     gatewayWwwDomain,
 			Subdomains: gatewaySubdomains,
+			Shares:     shares,
 		})
 		cmd.ErrCheck(err)
 		gateway.Start()
@@ -397,6 +539,56 @@ func getClientRPCOpts(target string) (opts []grpc.DialOption) {
 	return opts
 }
 
+func newPinner(ipfs iface.CoreAPI, store ds.Datastore) (pinning.Pinner, error) {
+	switch config.Viper.GetString("pinning.backend") {
+	case "ipfs":
+		p := pinning.NewIPFSPinner(ipfs, store)
+		if config.Viper.GetBool("pinning.ipfs.seed") {
+			if _, err := p.Seed(context.Background()); err != nil {
+				return nil, fmt.Errorf("seeding pins: %v", err)
+			}
+		}
+		return p, nil
+	case "remote":
+		return pinning.NewRemotePinner(pinning.RemoteConfig{
+			Endpoint: config.Viper.GetString("pinning.remote.endpoint"),
+			Token:    config.Viper.GetString("pinning.remote.token"),
+			Origins:  config.Viper.GetStringSlice("pinning.remote.origins"),
+			Name:     daemonName,
+		})
+	default:
+		return nil, errors.New("pinningBackend must be 'ipfs' or 'remote'")
+	}
+}
+
+// serverIdentityKey is the datastore key of the server identity's private key.
+var serverIdentityKey = ds.NewKey("/server/identity")
+
+// loadServerIdentity returns the server identity from store, creating it if needed.
+func loadServerIdentity(store ds.Datastore) (thread.Identity, error) {
+	val, err := store.Get(serverIdentityKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		val, err = crypto.MarshalPrivateKey(sk)
+		if err != nil {
+			return nil, err
+		}
+		if err := store.Put(serverIdentityKey, val); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	sk, err := crypto.UnmarshalPrivateKey(val)
+	if err != nil {
+		return nil, err
+	}
+	return thread.NewLibp2pIdentity(sk), nil
+}
+
 func newBadgerStore(repo string) (ds.TxnDatastore, error) {
 	if err := os.MkdirAll(repo, os.ModePerm); err != nil {
 		return nil, err
@@ -5,7 +5,9 @@ import (
 	"encoding/base64"
 	"fmt"
 	gopath "path"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/alecthomas/jsonschema"
@@ -42,15 +44,34 @@ var (
 
 // Bucket represents the buckets threaddb collection schema.
 type Bucket struct {
-	Key       string              `json:"_id"`
-	Owner     did.DID             `json:"owner"`
-	Name      string              `json:"name"`
-	Version   int                 `json:"version"`
-	LinkKey   string              `json:"key,omitempty"`
-	Path      string              `json:"path"` // @todo: This really should be Cid but will require a migration.
-	Metadata  map[string]Metadata `json:"metadata"`
-	CreatedAt int64               `json:"created_at"`
-	UpdatedAt int64               `json:"updated_at"`
+	Key      string              `json:"_id"`
+	Owner    did.DID             `json:"owner"`
+	Name     string              `json:"name"`
+	Version  int                 `json:"version"`
+	LinkKey  string              `json:"key,omitempty"`
+	Path     string              `json:"path"` // @todo: This really should be Cid but will require a migration.
+	Metadata map[string]Metadata `json:"metadata"`
+	History  []Root              `json:"history"`
+	// GroupOwners references the groups that are granted access roles in metadata by name,
+	// along with the owner each group had when it was granted a role.
+	// Group members are managed in the thread's groups collection and are resolved when the bucket is accessed,
+	// so a group that is deleted and recreated by another identity doesn't inherit its roles.
+	// Groups can only be referenced by the bucket owner.
+	GroupOwners map[string]did.DID `json:"group_owners,omitempty"`
+	CreatedAt   int64              `json:"created_at"`
+	UpdatedAt   int64              `json:"updated_at"`
+}
+
+// Root describes a bucket root in the bucket's history.
+type Root struct {
+	Path   string  `json:"path"`
+	Author did.DID `json:"author,omitempty"`
+	// Pinned indicates whether or not the root is still pinned and can be restored.
+	Pinned bool `json:"pinned"`
+	// Keys contains the file encryption keys that were in use when the root was created (private buckets only).
+	// Keys are dropped when the root is unpinned or the keys are rotated.
+	Keys      map[string]string `json:"keys,omitempty"`
+	CreatedAt int64             `json:"created_at"`
 }
 
 // Role describes an access role for a bucket item.
@@ -101,9 +122,13 @@ func (r Role) String() string {
 
 // Metadata contains metadata about a bucket item (a file or folder).
 type Metadata struct {
-	Key       string           `json:"key,omitempty"`
-	Roles     map[did.DID]Role `json:"roles"`
-	UpdatedAt int64            `json:"updated_at"`
+	Key   string           `json:"key,omitempty"`
+	Roles map[did.DID]Role `json:"roles"`
+	// Attributes are custom key/value pairs, e.g., content-type, tags, or checksums.
+	// Unlike roles, attributes are not inherited from parent folders.
+	// Attributes were added after Version1 and are therefore optional.
+	Attributes map[string]string `json:"attributes,omitempty"`
+	UpdatedAt  int64             `json:"updated_at"`
 }
 
 // NewDefaultMetadata returns the default metadata for a path.
@@ -191,13 +216,14 @@ func (b *Bucket) RotateFileEncryptionKeysForPrefix(pre string) error {
 	}
 
 	for p, md := range b.Metadata {
-		if strings.HasPrefix(p, pre) {
+		if pre == "" || p == pre || strings.HasPrefix(p, pre+"/") {
 			if md.Key != "" {
 				key, err := dcrypto.NewKey()
 				if err != nil {
 					return err
 				}
 				md.SetFileEncryptionKey(key)
+				b.Metadata[p] = md
 			}
 		}
 	}
@@ -254,6 +280,9 @@ func (b *Bucket) SetMetadataAtPath(pth string, md Metadata) {
 		if md.Roles != nil {
 			x.Roles = md.Roles
 		}
+		if md.Attributes != nil {
+			x.Attributes = md.Attributes
+		}
 		x.UpdatedAt = md.UpdatedAt
 		b.Metadata[pth] = x
 	} else {
@@ -264,6 +293,63 @@ func (b *Bucket) SetMetadataAtPath(pth string, md Metadata) {
 	}
 }
 
+// AddRoot appends the current root to the bucket history.
+func (b *Bucket) AddRoot(author did.DID, ts int64) {
+	b.History = append(b.History, Root{
+		Path:      b.Path,
+		Author:    author,
+		Pinned:    true,
+		Keys:      b.getFileEncryptionKeys(),
+		CreatedAt: ts,
+	})
+}
+
+// GetRoot returns the history entry matching root, which can be a path or a cid.
+func (b *Bucket) GetRoot(root string) (Root, bool) {
+	for i := len(b.History) - 1; i >= 0; i-- {
+		r := b.History[i]
+		if r.Path == root || strings.TrimPrefix(r.Path, "/ipfs/") == root {
+			return r, true
+		}
+	}
+	return Root{}, false
+}
+
+// SetFileEncryptionKeys replaces all metadata keys with keys.
+// New metadata is created for paths that do not have any.
+func (b *Bucket) SetFileEncryptionKeys(keys map[string]string) {
+	if b.Version == 0 {
+		return
+	}
+
+	for p, md := range b.Metadata {
+		md.Key = keys[p]
+		b.Metadata[p] = md
+	}
+	for p, k := range keys {
+		if _, ok := b.Metadata[p]; !ok {
+			b.Metadata[p] = Metadata{
+				Key:   k,
+				Roles: make(map[did.DID]Role),
+			}
+		}
+	}
+}
+
+// getFileEncryptionKeys returns all metadata keys by path.
+func (b *Bucket) getFileEncryptionKeys() map[string]string {
+	if !b.IsPrivate() {
+		return nil
+	}
+	keys := make(map[string]string)
+	for p, md := range b.Metadata {
+		if md.Key != "" {
+			keys[p] = md.Key
+		}
+	}
+	return keys
+}
+
 // UnsetMetadataWithPrefix removes metadata with the path prefix.
 func (b *Bucket) UnsetMetadataWithPrefix(pre string) {
 	if b.Version == 0 {
@@ -277,11 +363,95 @@ func (b *Bucket) UnsetMetadataWithPrefix(pre string) {
 	}
 }
 
+// TakeAttributesWithPrefix removes and returns attributes at the path prefix and its descendants.
+// The returned map is keyed by path relative to prefix, where the empty string denotes prefix itself.
+func (b *Bucket) TakeAttributesWithPrefix(pre string) map[string]map[string]string {
+	if b.Version == 0 {
+		return nil
+	}
+
+	attrs := make(map[string]map[string]string)
+	for p, md := range b.Metadata {
+		if len(md.Attributes) == 0 {
+			continue
+		}
+		if p == pre || pre == "" || strings.HasPrefix(p, pre+"/") {
+			attrs[strings.TrimPrefix(strings.TrimPrefix(p, pre), "/")] = md.Attributes
+			md.Attributes = nil
+			b.Metadata[p] = md
+		}
+	}
+	return attrs
+}
+
+// SetAttributesWithPrefix sets attributes at paths relative to prefix.
+// See TakeAttributesWithPrefix.
+func (b *Bucket) SetAttributesWithPrefix(pre string, attrs map[string]map[string]string, ts int64) {
+	for p, a := range attrs {
+		b.SetMetadataAtPath(gopath.Join(pre, p), Metadata{
+			Attributes: a,
+			UpdatedAt:  ts,
+		})
+	}
+}
+
+// ReferencesGroup returns whether or not a group is granted an access role at any path.
+func (b *Bucket) ReferencesGroup(name string) bool {
+	p := GroupPrincipal(name)
+	for _, md := range b.Metadata {
+		if _, ok := md.Roles[p]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupPaths returns the sorted paths at which a group is granted a role.
+func (b *Bucket) GroupPaths(name string) []string {
+	p := GroupPrincipal(name)
+	var paths []string
+	for pth, md := range b.Metadata {
+		if _, ok := md.Roles[p]; ok {
+			paths = append(paths, pth)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// SetGroup references a group owned by owner.
+func (b *Bucket) SetGroup(name string, owner did.DID) {
+	if b.GroupOwners == nil {
+		b.GroupOwners = make(map[string]did.DID)
+	}
+	b.GroupOwners[name] = owner
+}
+
+// PruneGroups removes references to groups that are no longer granted an access role at any path.
+func (b *Bucket) PruneGroups() {
+	for name := range b.GroupOwners {
+		if !b.ReferencesGroup(name) {
+			delete(b.GroupOwners, name)
+		}
+	}
+	if len(b.GroupOwners) == 0 {
+		b.GroupOwners = nil
+	}
+}
+
 // ensureNoNulls inflates any values that are nil due to schema updates.
 func (b *Bucket) ensureNoNulls() {
 	if b.Metadata == nil {
 		b.Metadata = make(map[string]Metadata)
 	}
+	if len(b.History) == 0 && b.Path != "" {
+		b.History = []Root{{
+			Path:      b.Path,
+			Pinned:    true,
+			Keys:      b.getFileEncryptionKeys(),
+			CreatedAt: b.UpdatedAt,
+		}}
+	}
 }
 
 // Copy returns a copy of the bucket.
@@ -290,16 +460,27 @@ func (b *Bucket) Copy() *Bucket {
 	for k, v := range b.Metadata {
 		md[k] = v
 	}
+	history := make([]Root, len(b.History))
+	copy(history, b.History)
+	var groupOwners map[string]did.DID
+	if b.GroupOwners != nil {
+		groupOwners = make(map[string]did.DID, len(b.GroupOwners))
+		for k, v := range b.GroupOwners {
+			groupOwners[k] = v
+		}
+	}
 	return &Bucket{
-		Key:       b.Key,
-		Owner:     b.Owner,
-		Name:      b.Name,
-		Version:   b.Version,
-		LinkKey:   b.LinkKey,
-		Path:      b.Path,
-		Metadata:  md,
-		CreatedAt: b.CreatedAt,
-		UpdatedAt: b.UpdatedAt,
+		Key:         b.Key,
+		Owner:       b.Owner,
+		Name:        b.Name,
+		Version:     b.Version,
+		LinkKey:     b.LinkKey,
+		Path:        b.Path,
+		Metadata:    md,
+		History:     history,
+		GroupOwners: groupOwners,
+		CreatedAt:   b.CreatedAt,
+		UpdatedAt:   b.UpdatedAt,
 	}
 }
 
@@ -340,7 +521,7 @@ func init() {
 			}
 			var type = event.patch.type
 			var patch = event.patch.json_patch
-			var restricted = ["owner", "name", "version", "key", "archives", "created_at"]
+			var restricted = ["owner", "name", "version", "key", "archives", "group_owners", "created_at"]
 			switch (type) {
 			  case "create":
 			    if (patch.owner !== "" && writer !== patch.owner) {
@@ -460,6 +641,34 @@ func init() {
 			    }
 			  }
 			}
+			if (instance.history) {
+			  for (i = 0; i < instance.history.length; i++) {
+			    var h = instance.history[i]
+			    if (!h.keys) {
+			      continue
+			    }
+			    // only expose keys for paths that are readable
+			    var hkeys = Object.keys(h.keys)
+			    hloop: for (j = 0; j < hkeys.length; j++) {
+			      var hparts = hkeys[j].split("/")
+			      if (hkeys[j].length > 0) {
+			        hparts.unshift("")
+			      }
+			      var hpath = ""
+			      for (k = 0; k < hparts.length; k++) {
+			        if (hpath.length > 0) {
+			          hpath += "/"
+			        }
+			        hpath += hparts[k]
+			        var y = instance.metadata[hpath]
+			        if (y && (y.roles[reader] > 0 || y.roles["*"] > 0)) {
+			          continue hloop
+			        }
+			      }
+			      delete h.keys[hkeys[j]]
+			    }
+			  }
+			}
 			instance.metadata = filtered
 			if (Object.keys(instance.metadata).length === 0) {
 			  delete instance.key
@@ -472,15 +681,34 @@ func init() {
 // Buckets is the threaddb collection for buckets.
 type Buckets struct {
 	Collection
+
+	lk         sync.Mutex
+	configured map[core.ID]struct{}
 }
 
 // NewBuckets returns a new buckets collection mananger.
-func NewBuckets(c *dbc.Client) (*Buckets, error) {
+// The read filter returns every instance unfiltered to server, and the write validator accepts every
+// change from server. This is used for maintenance reads that must see all bucket metadata and keys,
+// and for access that the collection can't check, like roles granted to groups, which the caller must check.
+func NewBuckets(c *dbc.Client, server did.DID) (*Buckets, error) {
+	if server == "" {
+		return nil, fmt.Errorf("server identity is required")
+	}
+	bc := config
+	bc.ReadFilter = fmt.Sprintf(`
+			if (reader === %q) {
+			  return instance
+			}`, server) + config.ReadFilter
+	bc.WriteValidator = fmt.Sprintf(`
+			if (writer === %q) {
+			  return true
+			}`, server) + config.WriteValidator
 	return &Buckets{
 		Collection: Collection{
 			c:      c,
-			config: config,
+			config: bc,
 		},
+		configured: make(map[core.ID]struct{}),
 	}, nil
 }
 
@@ -519,6 +747,7 @@ func (b *Buckets) New(
 		CreatedAt: created.UnixNano(),
 		UpdatedAt: created.UnixNano(),
 	}
+	bucket.AddRoot(owner, bucket.CreatedAt)
 	if _, err := b.Create(ctx, thread, bucket, WithIdentity(identity)); err != nil {
 		return nil, fmt.Errorf("creating bucket: %s", err)
 	}
@@ -534,3 +763,59 @@ func (b *Buckets) GetSafe(ctx context.Context, thread core.ID, key string, opts
 	bucket.ensureNoNulls()
 	return bucket, nil
 }
+
+// ListAll returns bucket instances in all threads managed by the client, keyed by thread.
+// Threads without a buckets collection are skipped.
+// Server must be a token for the server identity, so metadata and keys are included for every bucket.
+func (b *Buckets) ListAll(ctx context.Context, server did.Token) (map[core.ID][]*Bucket, error) {
+	dbs, err := b.c.ListDBs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing dbs: %v", err)
+	}
+	all := make(map[core.ID][]*Bucket)
+	for thread := range dbs {
+		ok, err := b.EnsureConfig(ctx, thread, server)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		res, err := b.List(ctx, thread, &db.Query{}, &Bucket{}, WithIdentity(server))
+		if err != nil {
+			return nil, fmt.Errorf("listing buckets in %s: %v", thread, err)
+		}
+		instances := res.([]*Bucket)
+		for _, in := range instances {
+			in.ensureNoNulls()
+		}
+		all[thread] = instances
+	}
+	return all, nil
+}
+
+// EnsureConfig updates the collection in thread if its read filter or write validator doesn't match
+// the current config, e.g., if it was created before the server identity was added or with another
+// server identity. Server must be a token for the server identity.
+// It returns false if thread doesn't have a buckets collection.
+func (b *Buckets) EnsureConfig(ctx context.Context, thread core.ID, server did.Token) (bool, error) {
+	b.lk.Lock()
+	defer b.lk.Unlock()
+	if _, ok := b.configured[thread]; ok {
+		return true, nil
+	}
+	current, err := b.c.GetCollectionInfo(ctx, thread, b.config.Name, db.WithManagedToken(server))
+	if isColNotFoundErr(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("getting collection in %s: %v", thread, err)
+	}
+	if current.ReadFilter != b.config.ReadFilter || current.WriteValidator != b.config.WriteValidator {
+		if err := b.updateCollection(ctx, thread, server); err != nil {
+			return false, fmt.Errorf("updating collection in %s: %v", thread, err)
+		}
+	}
+	b.configured[thread] = struct{}{}
+	return true, nil
+}
@@ -135,6 +135,20 @@ func (c *Collection) Delete(ctx context.Context, thread core.ID, id string, opts
 	return c.c.Delete(ctx, thread, c.config.Name, []string{id}, db.WithTxnToken(args.Identity))
 }
 
+// Listen returns a channel of events for a collection instance.
+func (c *Collection) Listen(ctx context.Context, thread core.ID, id string, opts ...Option) (
+	<-chan dbc.ListenEvent, error) {
+	args := &Options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	return c.c.Listen(ctx, thread, []dbc.ListenOption{{
+		Type:       dbc.ListenAll,
+		Collection: c.config.Name,
+		InstanceID: id,
+	}}, db.WithListenToken(args.Identity))
+}
+
 // WriteTxn wraps a write transaction in a collection.
 type WriteTxn struct {
 	c     *Collection
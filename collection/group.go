@@ -0,0 +1,167 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	dbc "github.com/textileio/go-threads/api/client"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+	db "github.com/textileio/go-threads/db"
+)
+
+const (
+	// GroupsName is the name of the threaddb collection used for groups.
+	GroupsName = "groups"
+	// GroupPrefix prefixes group names in access role principals, e.g., "group:eng".
+	GroupPrefix = "group:"
+)
+
+var (
+	groupSchema  *jsonschema.Schema
+	groupConfig  db.CollectionConfig
+	groupNameRxp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+)
+
+// Group represents the groups threaddb collection schema.
+// A group is a named set of identities in a thread that can be granted access roles
+// with the principal GroupPrincipal(name).
+type Group struct {
+	Name      string    `json:"_id"`
+	Owner     did.DID   `json:"owner"`
+	Members   []did.DID `json:"members"`
+	CreatedAt int64     `json:"created_at"`
+	UpdatedAt int64     `json:"updated_at"`
+}
+
+// HasMember returns whether or not id is a member of the group.
+func (g *Group) HasMember(id did.DID) bool {
+	for _, m := range g.Members {
+		if m == id {
+			return true
+		}
+	}
+	return false
+}
+
+// AddMembers adds members to the group, ignoring existing members.
+// Members are kept sorted.
+func (g *Group) AddMembers(members ...did.DID) {
+	for _, m := range members {
+		if !g.HasMember(m) {
+			g.Members = append(g.Members, m)
+		}
+	}
+	sortMembers(g.Members)
+}
+
+// RemoveMembers removes members from the group.
+func (g *Group) RemoveMembers(members ...did.DID) {
+	keep := g.Members[:0]
+	for _, m := range g.Members {
+		var remove bool
+		for _, x := range members {
+			if m == x {
+				remove = true
+				break
+			}
+		}
+		if !remove {
+			keep = append(keep, m)
+		}
+	}
+	g.Members = keep
+}
+
+// GroupPrincipal returns the access role principal for a group name.
+func GroupPrincipal(name string) did.DID {
+	return did.DID(GroupPrefix + name)
+}
+
+// GroupFromPrincipal returns the group name of an access role principal.
+// ok is false if the principal is not a group.
+func GroupFromPrincipal(p did.DID) (name string, ok bool) {
+	if !strings.HasPrefix(string(p), GroupPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(string(p), GroupPrefix), true
+}
+
+// ValidateGroupName returns an error if name is not a valid group name.
+func ValidateGroupName(name string) error {
+	if !groupNameRxp.MatchString(name) {
+		return fmt.Errorf("invalid group name: %s", name)
+	}
+	return nil
+}
+
+func sortMembers(members []did.DID) {
+	sort.Slice(members, func(i, j int) bool {
+		return members[i] < members[j]
+	})
+}
+
+func init() {
+	reflector := jsonschema.Reflector{ExpandedStruct: true}
+	groupSchema = reflector.Reflect(&Group{})
+	groupConfig = db.CollectionConfig{
+		Name:   GroupsName,
+		Schema: groupSchema,
+		WriteValidator: `
+			var type = event.patch.type
+			var patch = event.patch.json_patch
+			switch (type) {
+			  case "create":
+			    if (writer !== patch.owner) {
+			      return "permission denied" // writer must match new group owner
+			    }
+			    break
+			  case "save":
+			    if (writer !== instance.owner) {
+			      return "permission denied" // no owner access to edit members
+			    }
+			    if (patch.owner) {
+			      return "permission denied"
+			    }
+			    break
+			  case "delete":
+			    if (writer !== instance.owner) {
+			      return "permission denied" // no owner access to delete group
+			    }
+			    break
+			}
+			return true
+		`,
+	}
+}
+
+// Groups is the threaddb collection for groups.
+type Groups struct {
+	Collection
+}
+
+// NewGroups returns a new groups collection mananger.
+func NewGroups(c *dbc.Client) (*Groups, error) {
+	return &Groups{
+		Collection: Collection{
+			c:      c,
+			config: groupConfig,
+		},
+	}, nil
+}
+
+// GetSafe gets a group instance and inflates any values that are missing.
+func (g *Groups) GetSafe(ctx context.Context, thread core.ID, name string, opts ...Option) (*Group, error) {
+	group := &Group{}
+	if err := g.Get(ctx, thread, name, group, opts...); err != nil {
+		return nil, fmt.Errorf("getting group: %v", err)
+	}
+	if group.Members == nil {
+		group.Members = []did.DID{}
+	}
+	return group, nil
+}
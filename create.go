@@ -7,6 +7,7 @@ import (
 
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/dcrypto"
+	"github.com/textileio/go-buckets/audit"
 	"github.com/textileio/go-buckets/collection"
 	"github.com/textileio/go-buckets/dag"
 	"github.com/textileio/go-threads/core/did"
@@ -39,6 +40,7 @@ func (b *Buckets) Create(
 	if err != nil {
 		return nil, nil, 0, fmt.Errorf("validating identity: %v", err)
 	}
+	ctx = dag.NewOwnerContext(ctx, owner)
 
 	// Create bucket keys if private
 	var linkKey, fileKey []byte
@@ -66,6 +68,7 @@ func (b *Buckets) Create(
 		ctx, pth, err = dag.CreateBucketPathWithCid(
 			ctx,
 			b.ipfs,
+			b.pinner,
 			"",
 			args.Cid,
 			linkKey,
@@ -76,7 +79,7 @@ func (b *Buckets) Create(
 			return nil, nil, 0, fmt.Errorf("creating bucket with cid: %v", err)
 		}
 	} else {
-		ctx, pth, err = dag.CreateBucketPath(ctx, b.ipfs, seed, linkKey)
+		ctx, pth, err = dag.CreateBucketPath(ctx, b.ipfs, b.pinner, seed, linkKey)
 		if err != nil {
 			return nil, nil, 0, fmt.Errorf("creating bucket: %v", err)
 		}
@@ -135,6 +138,8 @@ func (b *Buckets) Create(
 	// Publish the new bucket's address to the name system
 	go b.ipns.Publish(pth, instance.Key)
 
+	b.record(ctx, args.Thread, instance.Key, owner, audit.OpCreate, nil, "", instance.Path)
+
 	log.Debugf("created %s", key)
 	return instanceToBucket(args.Thread, instance), seedInfo, dag.GetPinnedBytes(ctx), nil
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/dcrypto"
 	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/pinning"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -27,6 +28,7 @@ import (
 var ErrInvalidNodeType = errors.New("invalid node type")
 
 // EncryptData encrypts data with the new key, decrypting with current key if needed.
+// If newKey is nil, data is only decrypted.
 func EncryptData(data, currentKey, newKey []byte) ([]byte, error) {
 	if currentKey != nil {
 		var err error
@@ -35,6 +37,9 @@ func EncryptData(data, currentKey, newKey []byte) ([]byte, error) {
 			return nil, err
 		}
 	}
+	if newKey == nil {
+		return data, nil
+	}
 	r, err := dcrypto.NewEncrypter(bytes.NewReader(data), newKey)
 	if err != nil {
 		return nil, err
@@ -147,6 +152,39 @@ func EncryptDag(
 	newFileKeys map[string][]byte,
 	newFileKey []byte,
 	add *NamedNode,
+) (map[cid.Cid]*NamedNode, error) {
+	var currentLinkKey []byte
+	if currentFileKeys != nil {
+		currentLinkKey = linkKey
+	}
+	return ReEncryptDag(
+		ctx,
+		ipfs,
+		root,
+		destPath,
+		currentLinkKey,
+		linkKey,
+		currentFileKeys,
+		newFileKeys,
+		newFileKey,
+		add,
+	)
+}
+
+// ReEncryptDag is like EncryptDag, but allows the link key to change.
+// Joint nodes are decrypted with currentLinkKey if it's not nil and encrypted with newLinkKey.
+// If newLinkKey is nil, the dag is decrypted into plain UnixFS.
+func ReEncryptDag(
+	ctx context.Context,
+	ipfs iface.CoreAPI,
+	root ipld.Node,
+	destPath string,
+	currentLinkKey,
+	newLinkKey []byte,
+	currentFileKeys,
+	newFileKeys map[string][]byte,
+	newFileKey []byte,
+	add *NamedNode,
 ) (map[cid.Cid]*NamedNode, error) {
 	// Step 1: Create a preordered list of joint and leaf nodes
 	var stack, joints []*NamedNode
@@ -175,9 +213,9 @@ func EncryptDag(
 		case *mdag.ProtoNode:
 			// Add links to the stack
 			cur.Cid = cur.Node.Cid()
-			if currentFileKeys != nil {
+			if currentLinkKey != nil {
 				var err error
-				cur.Node, _, err = DecryptNode(cur.Node, linkKey)
+				cur.Node, _, err = DecryptNode(cur.Node, currentLinkKey)
 				if err != nil {
 					return nil, err
 				}
@@ -213,7 +251,7 @@ func EncryptDag(
 		l := l
 		cfk := getFileKey(nil, currentFileKeys, l.Path)
 		nfk := getFileKey(newFileKey, newFileKeys, l.Path)
-		if nfk == nil {
+		if nfk == nil && newLinkKey != nil {
 			// This shouldn't happen
 			return nil, fmt.Errorf("new file key not found for path %s", l.Path)
 		}
@@ -269,7 +307,7 @@ func EncryptDag(
 			}
 			nmap.Store(add.Node.Cid(), add)
 		}
-		cn, err := EncryptNode(dir, linkKey)
+		cn, err := EncryptNode(dir, newLinkKey)
 		if err != nil {
 			return nil, err
 		}
@@ -286,6 +324,7 @@ func EncryptDag(
 func CopyDag(
 	ctx context.Context,
 	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
 	buck *collection.Bucket,
 	root ipld.Node,
 	fromPath string,
@@ -328,6 +367,7 @@ func CopyDag(
 	ctx, dirPath, err := InsertNodeAtPath(
 		ctx,
 		ipfs,
+		pinner,
 		pn,
 		path.Join(path.New(buck.Path), toPath),
 		buck.GetLinkEncryptionKey(),
@@ -352,7 +392,7 @@ func CopyDag(
 		nodes = append(nodes, sn)
 	}
 
-	ctx, err = AddAndPinNodes(ctx, ipfs, nodes)
+	ctx, err = AddAndPinNodes(ctx, ipfs, pinner, nodes)
 	if err != nil {
 		return ctx, nil, err
 	}
@@ -360,18 +400,28 @@ func CopyDag(
 	return ctx, dirPath, nil
 }
 
+// getFileKey returns the key for pth or its nearest parent in pathKeys, falling back to key.
+// Paths added as part of a folder don't have their own keys.
 func getFileKey(key []byte, pathKeys map[string][]byte, pth string) []byte {
 	if pathKeys == nil {
 		return key
 	}
-	k, ok := pathKeys[pth]
-	if ok {
-		return k
+	for {
+		if k, ok := pathKeys[pth]; ok {
+			return k
+		}
+		if pth == "" {
+			return key
+		}
+		pth = gopath.Dir(pth)
+		if pth == "." || pth == "/" {
+			pth = ""
+		}
 	}
-	return key
 }
 
 // EncryptFileNode encrypts node with the new key, decrypting with current key if needed.
+// If newKey is nil, the node is only decrypted.
 func EncryptFileNode(
 	ctx context.Context,
 	ipfs iface.CoreAPI,
@@ -399,9 +449,12 @@ func EncryptFileNode(
 	} else {
 		r1 = file
 	}
-	r2, err := dcrypto.NewEncrypter(r1, newKey)
-	if err != nil {
-		return nil, err
+	r2 := r1
+	if newKey != nil {
+		r2, err = dcrypto.NewEncrypter(r1, newKey)
+		if err != nil {
+			return nil, err
+		}
 	}
 	pth, err := ipfs.Unixfs().Add(
 		ctx,
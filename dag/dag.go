@@ -15,6 +15,7 @@ import (
 	iface "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/pinning"
 	"github.com/textileio/go-buckets/util"
 )
 
@@ -40,6 +41,7 @@ func MakeBucketSeed(key []byte) (ipld.Node, error) {
 func CreateBucketPath(
 	ctx context.Context,
 	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
 	seed ipld.Node,
 	key []byte,
 ) (context.Context, path.Resolved, error) {
@@ -55,7 +57,7 @@ func CreateBucketPath(
 	if key != nil {
 		pins = append(pins, seed)
 	}
-	ctx, err = PinBlocks(ctx, ipfs, pins)
+	ctx, err = PinBlocks(ctx, ipfs, pinner, pins)
 	if err != nil {
 		return ctx, nil, err
 	}
@@ -68,6 +70,7 @@ func CreateBucketPath(
 func CreateBucketPathWithCid(
 	ctx context.Context,
 	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
 	buckPath string,
 	cid cid.Cid,
 	linkKey,
@@ -75,16 +78,6 @@ func CreateBucketPathWithCid(
 	seed ipld.Node,
 ) (context.Context, path.Resolved, error) {
 	cidPath := path.IpfsPath(cid)
-	cidSize, err := GetPathSize(ctx, ipfs, cidPath)
-	if err != nil {
-		return ctx, nil, fmt.Errorf("resolving boot cid node: %v", err)
-	}
-
-	// Check context owner's storage allowance
-	owner, ok := OwnerFromContext(ctx)
-	if ok && cidSize > owner.StorageAvailable {
-		return ctx, nil, ErrStorageQuotaExhausted
-	}
 
 	// Here we have to walk and possibly encrypt the boot path dag
 	n, nodes, err := NewDirFromExistingPath(
@@ -109,13 +102,95 @@ func CreateBucketPathWithCid(
 	} else {
 		pins = []ipld.Node{n}
 	}
-	ctx, err = PinBlocks(ctx, ipfs, pins)
+	ctx, err = PinBlocks(ctx, ipfs, pinner, pins)
 	if err != nil {
 		return ctx, nil, err
 	}
 	return ctx, path.IpfsPath(n.Cid()), nil
 }
 
+// CreateBucketPathFromRoot creates an IPFS path from an existing bucket root.
+// If newLinkKey is not nil, the dag is encrypted with the new keys, decrypting with
+// the current keys if currentLinkKey is not nil.
+// If only currentLinkKey is not nil, the dag is decrypted into plain UnixFS.
+// Otherwise, the root's seed file is replaced with seed, which ensures the new path is unique.
+// The returned path will be pinned.
+func CreateBucketPathFromRoot(
+	ctx context.Context,
+	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
+	root path.Resolved,
+	currentLinkKey []byte,
+	currentFileKeys map[string][]byte,
+	newLinkKey []byte,
+	newFileKeys map[string][]byte,
+	newFileKey []byte,
+	seed ipld.Node,
+) (context.Context, path.Resolved, error) {
+	rn, err := ipfs.ResolveNode(ctx, root)
+	if err != nil {
+		return ctx, nil, err
+	}
+	if newLinkKey == nil && currentLinkKey == nil {
+		top, ok := rn.(*dag.ProtoNode)
+		if !ok {
+			return ctx, nil, dag.ErrNotProtobuf
+		}
+		n := top.Copy().(*dag.ProtoNode)
+		if err := n.RemoveNodeLink(collection.SeedName); err != nil && !errors.Is(err, dag.ErrLinkNotFound) {
+			return ctx, nil, err
+		}
+		if err := n.AddNodeLink(collection.SeedName, seed); err != nil {
+			return ctx, nil, err
+		}
+		if err := ipfs.Dag().AddMany(ctx, []ipld.Node{n, seed}); err != nil {
+			return ctx, nil, err
+		}
+		ctx, err = PinBlocks(ctx, ipfs, pinner, []ipld.Node{n})
+		if err != nil {
+			return ctx, nil, err
+		}
+		return ctx, path.IpfsPath(n.Cid()), nil
+	}
+
+	// Walk the root, (re-)encrypting the leaves and directories
+	nmap, err := ReEncryptDag(
+		ctx,
+		ipfs,
+		rn,
+		"",
+		currentLinkKey,
+		newLinkKey,
+		currentFileKeys,
+		newFileKeys,
+		newFileKey,
+		nil,
+	)
+	if err != nil {
+		return ctx, nil, err
+	}
+	nodes := make([]ipld.Node, len(nmap))
+	i := 0
+	for _, tn := range nmap {
+		nodes[i] = tn.Node
+		i++
+	}
+	top := nmap[rn.Cid()].Node
+	if newLinkKey == nil {
+		// Plain dags are pinned recursively from the root
+		if err := ipfs.Dag().AddMany(ctx, nodes); err != nil {
+			return ctx, nil, err
+		}
+		ctx, err = PinBlocks(ctx, ipfs, pinner, []ipld.Node{top})
+	} else {
+		ctx, err = AddAndPinNodes(ctx, ipfs, pinner, nodes)
+	}
+	if err != nil {
+		return ctx, nil, err
+	}
+	return ctx, path.IpfsPath(top.Cid()), nil
+}
+
 // newDirWithNode returns a new proto node directory wrapping the node,
 // which is encrypted if key is not nil.
 func newDirWithNode(n ipld.Node, name string, key []byte) (ipld.Node, error) {
@@ -295,6 +370,7 @@ func getLink(lnks []*ipld.Link, name string) *ipld.Link {
 func InsertNodeAtPath(
 	ctx context.Context,
 	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
 	child ipld.Node,
 	pth path.Path,
 	key []byte,
@@ -378,7 +454,7 @@ func InsertNodeAtPath(
 		return ctx, nil, err
 	}
 	// Pin brand new nodes
-	ctx, err = PinBlocks(ctx, ipfs, news)
+	ctx, err = PinBlocks(ctx, ipfs, pinner, news)
 	if err != nil {
 		return ctx, nil, err
 	}
@@ -386,12 +462,12 @@ func InsertNodeAtPath(
 	// Update changed node pins
 	for _, n := range np {
 		if n.Old != nil && n.IsJoint {
-			ctx, err = UnpinBranch(ctx, ipfs, n.Old, key)
+			ctx, err = UnpinBranch(ctx, ipfs, pinner, n.Old, key)
 			if err != nil {
 				return ctx, nil, err
 			}
 		}
-		ctx, err = UpdateOrAddPin(ctx, ipfs, n.Old, path.IpfsPath(n.New.Cid()))
+		ctx, err = UpdateOrAddPin(ctx, ipfs, pinner, n.Old, path.IpfsPath(n.New.Cid()))
 		if err != nil {
 			return ctx, nil, err
 		}
@@ -404,6 +480,7 @@ func InsertNodeAtPath(
 func RemoveNodeAtPath(
 	ctx context.Context,
 	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
 	pth path.Path,
 	key []byte,
 ) (context.Context, path.Resolved, error) {
@@ -457,12 +534,12 @@ func RemoveNodeAtPath(
 	// Update / remove node pins
 	for _, n := range np {
 		if n.IsJoint {
-			ctx, err = UnpinNodeAndBranch(ctx, ipfs, n.Old, key)
+			ctx, err = UnpinNodeAndBranch(ctx, ipfs, pinner, n.Old, key)
 			if err != nil {
 				return ctx, nil, err
 			}
 		} else {
-			ctx, err = UpdateOrAddPin(ctx, ipfs, n.Old, path.IpfsPath(n.New.Cid()))
+			ctx, err = UpdateOrAddPin(ctx, ipfs, pinner, n.Old, path.IpfsPath(n.New.Cid()))
 			if err != nil {
 				return ctx, nil, err
 			}
@@ -4,34 +4,76 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
+	"github.com/ipfs/go-cid"
 	ipld "github.com/ipfs/go-ipld-format"
+	logging "github.com/ipfs/go-log/v2"
 	iface "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/ipfs/interface-go-ipfs-core/path"
-)
-
-const (
-	// pinNotRecursiveMsg is used to match an IPFS "recursively pinned already" error.
-	pinNotRecursiveMsg = "'from' cid was not recursively pinned already"
+	"github.com/textileio/go-buckets/pinning"
+	"github.com/textileio/go-threads/core/did"
 )
 
 var (
+	log = logging.Logger("buckets-dag")
+
 	// ErrStorageQuotaExhausted indicates the requested operation exceeds the storage allowance.
 	ErrStorageQuotaExhausted = errors.New("storage quota exhausted")
 )
 
 type ctxKey string
 
+// Accountant charges pinned storage to bucket owners.
+type Accountant interface {
+	// Reserve atomically adds delta bytes to the storage used by owner.
+	// A positive delta that exceeds the owner's storage allowance is not applied and
+	// returns ErrStorageQuotaExhausted. A negative delta releases storage.
+	Reserve(owner did.DID, delta int64) error
+}
+
 // BucketOwner provides owner context to the bucket service.
 type BucketOwner struct {
-	StorageUsed      int64
-	StorageAvailable int64
-	StorageDelta     int64
+	// Identity is the DID of the bucket owner.
+	Identity did.DID
+	// StorageDelta is the storage charged to the owner with context.
+	StorageDelta int64
+
+	accountant Accountant
+	lk         sync.Mutex
+}
+
+// reserve charges delta bytes to the owner.
+func (o *BucketOwner) reserve(delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+	if err := o.accountant.Reserve(o.Identity, delta); err != nil {
+		return err
+	}
+	o.lk.Lock()
+	o.StorageDelta += delta
+	o.lk.Unlock()
+	return nil
+}
+
+// NewAccountantContext returns a new context that charges storage pinned for bucket owners to a.
+func NewAccountantContext(ctx context.Context, a Accountant) context.Context {
+	return context.WithValue(ctx, ctxKey("accountant"), a)
 }
 
 // NewOwnerContext returns a new bucket owner context.
-func NewOwnerContext(ctx context.Context, owner *BucketOwner) context.Context {
-	return context.WithValue(ctx, ctxKey("bucketOwner"), owner)
+// Storage pinned with the returned context is charged to owner.
+// If context has no accountant, storage is not charged.
+func NewOwnerContext(ctx context.Context, owner did.DID) context.Context {
+	a, ok := ctx.Value(ctxKey("accountant")).(Accountant)
+	if !ok || owner == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKey("bucketOwner"), &BucketOwner{
+		Identity:   owner,
+		accountant: a,
+	})
 }
 
 // OwnerFromContext returns a bucket owner from the context if available.
@@ -40,18 +82,30 @@ func OwnerFromContext(ctx context.Context) (*BucketOwner, bool) {
 	return owner, ok
 }
 
+// reserveStorage charges delta bytes to the context owner, if any.
+func reserveStorage(ctx context.Context, delta int64) error {
+	owner, ok := OwnerFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return owner.reserve(delta)
+}
+
+// releaseStorage returns delta bytes reserved for an operation that failed to the context owner.
+func releaseStorage(ctx context.Context, delta int64) {
+	owner, ok := OwnerFromContext(ctx)
+	if !ok {
+		return
+	}
+	if err := owner.reserve(-delta); err != nil {
+		log.Errorf("releasing storage for %s: %v", owner.Identity, err)
+	}
+}
+
 // AddPinnedBytes adds the provided delta to a running total for context.
 func AddPinnedBytes(ctx context.Context, delta int64) context.Context {
 	total, _ := ctx.Value(ctxKey("pinnedBytes")).(int64)
-	ctx = context.WithValue(ctx, ctxKey("pinnedBytes"), total+delta)
-	owner, ok := OwnerFromContext(ctx)
-	if ok {
-		owner.StorageUsed += delta
-		owner.StorageAvailable -= delta
-		owner.StorageDelta += delta
-		ctx = NewOwnerContext(ctx, owner)
-	}
-	return ctx
+	return context.WithValue(ctx, ctxKey("pinnedBytes"), total+delta)
 }
 
 // GetPinnedBytes returns the total pinned bytes for context.
@@ -61,7 +115,13 @@ func GetPinnedBytes(ctx context.Context) int64 {
 }
 
 // PinBlocks pins blocks, accounting for sum bytes pinned for context.
-func PinBlocks(ctx context.Context, ipfs iface.CoreAPI, nodes []ipld.Node) (context.Context, error) {
+// The blocks must already be added to the IPFS node.
+func PinBlocks(
+	ctx context.Context,
+	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
+	nodes []ipld.Node,
+) (context.Context, error) {
 	var totalAddedSize int64
 	for _, n := range nodes {
 		s, err := n.Stat()
@@ -71,29 +131,41 @@ func PinBlocks(ctx context.Context, ipfs iface.CoreAPI, nodes []ipld.Node) (cont
 		totalAddedSize += int64(s.CumulativeSize)
 	}
 
-	// Check context owner's storage allowance
-	owner, ok := OwnerFromContext(ctx)
-	if ok && totalAddedSize > owner.StorageAvailable {
-		return ctx, ErrStorageQuotaExhausted
+	// Charge the context owner
+	if err := reserveStorage(ctx, totalAddedSize); err != nil {
+		return ctx, err
 	}
 
-	if err := ipfs.Dag().Pinning().AddMany(ctx, nodes); err != nil {
-		return ctx, fmt.Errorf("pinning set of nodes: %v", err)
+	for _, n := range nodes {
+		if err := pinner.Add(ctx, n.Cid()); err != nil {
+			releaseStorage(ctx, totalAddedSize)
+			return ctx, fmt.Errorf("pinning set of nodes: %v", err)
+		}
 	}
 	return AddPinnedBytes(ctx, totalAddedSize), nil
 }
 
 // AddAndPinNodes adds and pins nodes, accounting for sum bytes pinned for context.
-func AddAndPinNodes(ctx context.Context, ipfs iface.CoreAPI, nodes []ipld.Node) (context.Context, error) {
+func AddAndPinNodes(
+	ctx context.Context,
+	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
+	nodes []ipld.Node,
+) (context.Context, error) {
 	if err := ipfs.Dag().AddMany(ctx, nodes); err != nil {
 		return ctx, err
 	}
-	return PinBlocks(ctx, ipfs, nodes)
+	return PinBlocks(ctx, ipfs, pinner, nodes)
 }
 
 // UpdateOrAddPin moves the pin at from to to.
 // If from is nil, a new pin as placed at to.
-func UpdateOrAddPin(ctx context.Context, ipfs iface.CoreAPI, from, to path.Path) (context.Context, error) {
+func UpdateOrAddPin(
+	ctx context.Context,
+	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
+	from, to path.Path,
+) (context.Context, error) {
 	toSize, err := GetPathSize(ctx, ipfs, to)
 	if err != nil {
 		return ctx, fmt.Errorf("getting size of destination dag: %v", err)
@@ -105,41 +177,134 @@ func UpdateOrAddPin(ctx context.Context, ipfs iface.CoreAPI, from, to path.Path)
 	}
 	deltaSize := -fromSize + toSize
 
-	// Check context owner's storage allowance
-	owner, ok := OwnerFromContext(ctx)
-	if ok && deltaSize > owner.StorageAvailable {
-		return ctx, ErrStorageQuotaExhausted
+	toCid, err := resolveCid(ctx, ipfs, to)
+	if err != nil {
+		return ctx, err
 	}
-
-	if from == nil {
-		if err := ipfs.Pin().Add(ctx, to); err != nil {
+	var fromCid cid.Cid
+	if from != nil {
+		fromCid, err = resolveCid(ctx, ipfs, from)
+		if err != nil {
 			return ctx, err
 		}
+	}
+
+	// Charge the context owner
+	if err := reserveStorage(ctx, deltaSize); err != nil {
+		return ctx, err
+	}
+
+	if from == nil {
+		err = pinner.Add(ctx, toCid)
 	} else {
-		if err := ipfs.Pin().Update(ctx, from, to); err != nil {
-			if err.Error() == pinNotRecursiveMsg {
-				return ctx, ipfs.Pin().Add(ctx, to)
-			}
+		err = pinner.Update(ctx, fromCid, toCid)
+	}
+	if err != nil {
+		releaseStorage(ctx, deltaSize)
+		return ctx, err
+	}
+	return AddPinnedBytes(ctx, deltaSize), nil
+}
+
+// PinPath pins path and accounts for sum bytes pinned for context.
+func PinPath(
+	ctx context.Context,
+	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
+	path path.Path,
+) (context.Context, error) {
+	size, err := GetPathSize(ctx, ipfs, path)
+	if err != nil {
+		return ctx, fmt.Errorf("getting size of node: %v", err)
+	}
+
+	c, err := resolveCid(ctx, ipfs, path)
+	if err != nil {
+		return ctx, err
+	}
+
+	// Charge the context owner
+	if err := reserveStorage(ctx, size); err != nil {
+		return ctx, err
+	}
+
+	if err := pinner.Add(ctx, c); err != nil {
+		releaseStorage(ctx, size)
+		return ctx, err
+	}
+	return AddPinnedBytes(ctx, size), nil
+}
+
+// PinNodeAndBranch pins a node and its entire branch, accounting for sum bytes pinned for context.
+// Nodes that are already pinned are skipped along with their branch.
+// If key is nil, only the node is pinned, which recursively pins the branch.
+func PinNodeAndBranch(
+	ctx context.Context,
+	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
+	pth path.Resolved,
+	key []byte,
+) (context.Context, error) {
+	pinned, err := pinner.IsPinned(ctx, pth.Cid())
+	if err != nil {
+		return ctx, err
+	}
+	if pinned {
+		return ctx, nil
+	}
+	ctx, err = PinPath(ctx, ipfs, pinner, pth)
+	if err != nil {
+		return ctx, err
+	}
+	if key == nil {
+		return ctx, nil
+	}
+	n, _, err := ResolveNodeAtPath(ctx, ipfs, pth, key)
+	if err != nil {
+		return ctx, err
+	}
+	for _, l := range n.Links() {
+		if l.Name == "" {
+			continue // Data nodes will never be pinned directly
+		}
+		ctx, err = PinNodeAndBranch(ctx, ipfs, pinner, path.IpfsPath(l.Cid), key)
+		if err != nil {
 			return ctx, err
 		}
 	}
-	return AddPinnedBytes(ctx, deltaSize), nil
+	return ctx, nil
 }
 
 // UnpinPath unpins path and accounts for sum bytes pinned for context.
-func UnpinPath(ctx context.Context, ipfs iface.CoreAPI, path path.Path) (context.Context, error) {
-	if err := ipfs.Pin().Rm(ctx, path); err != nil {
+func UnpinPath(
+	ctx context.Context,
+	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
+	path path.Path,
+) (context.Context, error) {
+	c, err := resolveCid(ctx, ipfs, path)
+	if err != nil {
+		return ctx, err
+	}
+	if err := pinner.Rm(ctx, c); err != nil {
 		return ctx, err
 	}
 	size, err := GetPathSize(ctx, ipfs, path)
 	if err != nil {
 		return ctx, fmt.Errorf("getting size of removed node: %v", err)
 	}
+	releaseStorage(ctx, size)
 	return AddPinnedBytes(ctx, -size), nil
 }
 
 // UnpinBranch walks a the node at path, decrypting (if needed) and unpinning all nodes
-func UnpinBranch(ctx context.Context, ipfs iface.CoreAPI, p path.Resolved, key []byte) (context.Context, error) {
+func UnpinBranch(
+	ctx context.Context,
+	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
+	p path.Resolved,
+	key []byte,
+) (context.Context, error) {
 	n, _, err := ResolveNodeAtPath(ctx, ipfs, p, key)
 	if err != nil {
 		return ctx, err
@@ -149,11 +314,11 @@ func UnpinBranch(ctx context.Context, ipfs iface.CoreAPI, p path.Resolved, key [
 			continue // Data nodes will never be pinned directly
 		}
 		lp := path.IpfsPath(l.Cid)
-		ctx, err = UnpinPath(ctx, ipfs, lp)
+		ctx, err = UnpinPath(ctx, ipfs, pinner, lp)
 		if err != nil {
 			return ctx, err
 		}
-		ctx, err = UnpinBranch(ctx, ipfs, lp, key)
+		ctx, err = UnpinBranch(ctx, ipfs, pinner, lp, key)
 		if err != nil {
 			return ctx, err
 		}
@@ -165,12 +330,110 @@ func UnpinBranch(ctx context.Context, ipfs iface.CoreAPI, p path.Resolved, key [
 func UnpinNodeAndBranch(
 	ctx context.Context,
 	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
 	pth path.Resolved,
 	key []byte,
 ) (context.Context, error) {
-	ctx, err := UnpinBranch(ctx, ipfs, pth, key)
+	ctx, err := UnpinBranch(ctx, ipfs, pinner, pth, key)
 	if err != nil {
 		return ctx, err
 	}
-	return UnpinPath(ctx, ipfs, pth)
+	return UnpinPath(ctx, ipfs, pinner, pth)
+}
+
+// UnpinNodesAndBranches unpins nodes and their entire branches, accounting for sum bytes pinned for context.
+// Nodes that are reachable from any of the paths in keep are not unpinned.
+// If key is nil, only the nodes are unpinned, which recursively unpins the branches.
+func UnpinNodesAndBranches(
+	ctx context.Context,
+	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
+	pths []path.Resolved,
+	keep []path.Resolved,
+	key []byte,
+) (context.Context, error) {
+	seen := make(map[cid.Cid]struct{})
+	for _, p := range keep {
+		if key == nil {
+			seen[p.Cid()] = struct{}{}
+		} else if err := walkBranch(ctx, ipfs, p, key, seen); err != nil {
+			return ctx, err
+		}
+	}
+	var err error
+	for _, p := range pths {
+		ctx, err = unpinBranch(ctx, ipfs, pinner, p, key, seen)
+		if err != nil {
+			return ctx, err
+		}
+	}
+	return ctx, nil
+}
+
+func unpinBranch(
+	ctx context.Context,
+	ipfs iface.CoreAPI,
+	pinner pinning.Pinner,
+	p path.Resolved,
+	key []byte,
+	seen map[cid.Cid]struct{},
+) (context.Context, error) {
+	if _, ok := seen[p.Cid()]; ok {
+		return ctx, nil
+	}
+	seen[p.Cid()] = struct{}{}
+	if key != nil {
+		n, _, err := ResolveNodeAtPath(ctx, ipfs, p, key)
+		if err != nil {
+			return ctx, err
+		}
+		for _, l := range n.Links() {
+			if l.Name == "" {
+				continue // Data nodes will never be pinned directly
+			}
+			ctx, err = unpinBranch(ctx, ipfs, pinner, path.IpfsPath(l.Cid), key, seen)
+			if err != nil {
+				return ctx, err
+			}
+		}
+	}
+	return UnpinPath(ctx, ipfs, pinner, p)
+}
+
+func walkBranch(
+	ctx context.Context,
+	ipfs iface.CoreAPI,
+	p path.Resolved,
+	key []byte,
+	seen map[cid.Cid]struct{},
+) error {
+	if _, ok := seen[p.Cid()]; ok {
+		return nil
+	}
+	seen[p.Cid()] = struct{}{}
+	n, _, err := ResolveNodeAtPath(ctx, ipfs, p, key)
+	if err != nil {
+		return err
+	}
+	for _, l := range n.Links() {
+		if l.Name == "" {
+			continue
+		}
+		if err := walkBranch(ctx, ipfs, path.IpfsPath(l.Cid), key, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveCid returns the cid at path.
+func resolveCid(ctx context.Context, ipfs iface.CoreAPI, p path.Path) (cid.Cid, error) {
+	if r, ok := p.(path.Resolved); ok {
+		return r.Cid(), nil
+	}
+	r, err := ipfs.ResolvePath(ctx, p)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("resolving path: %v", err)
+	}
+	return r.Cid(), nil
 }
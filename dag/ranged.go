@@ -0,0 +1,150 @@
+package dag
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/textileio/dcrypto"
+)
+
+var (
+	// ErrInvalidRange indicates a range that lies outside of a file.
+	ErrInvalidRange = errors.New("invalid range")
+
+	// ErrCorruptCiphertext indicates ciphertext that fails HMAC verification.
+	ErrCorruptCiphertext = errors.New("message corrupt or incorrect key")
+)
+
+// dcrypto v1 ciphertext is a version number and an IV followed by AES-CTR ciphertext and
+// an HMAC-SHA512 of the IV and ciphertext.
+// The first half of a key is used for AES and the second half for HMAC.
+const (
+	cryptoVersionSize = 4
+	cryptoHeaderSize  = cryptoVersionSize + aes.BlockSize
+	cryptoMACSize     = sha512.Size
+	cryptoOverhead    = cryptoHeaderSize + cryptoMACSize
+	cryptoAESKeySize  = 32
+	cryptoKeySize     = 64
+)
+
+// DecryptedSize returns the plaintext size of size bytes of dcrypto output.
+func DecryptedSize(size int64) (int64, error) {
+	if size < cryptoOverhead {
+		return 0, fmt.Errorf("ciphertext is too small")
+	}
+	return size - cryptoOverhead, nil
+}
+
+// NewRangeDecrypter returns a reader of plaintext starting at offset from r,
+// which contains size bytes of data encrypted with key.
+// Like dcrypto.NewDecrypter, the HMAC is verified before any plaintext is returned,
+// which requires reading all of r. Unlike dcrypto.NewDecrypter, ciphertext is not
+// copied to a temporary file and decryption starts at the block containing offset.
+// Use NewVerifiedRangeDecrypter to read other ranges of the same ciphertext without
+// verifying it again.
+func NewRangeDecrypter(r io.ReadSeeker, key []byte, size, offset int64) (io.Reader, error) {
+	if err := VerifyCiphertext(r, key, size); err != nil {
+		return nil, err
+	}
+	return NewVerifiedRangeDecrypter(r, key, size, offset)
+}
+
+// VerifyCiphertext verifies the HMAC of r, which contains size bytes of data encrypted with key.
+// All of r is read.
+func VerifyCiphertext(r io.ReadSeeker, key []byte, size int64) error {
+	if len(key) != cryptoKeySize {
+		return fmt.Errorf("invalid key size")
+	}
+	plainSize, err := DecryptedSize(size)
+	if err != nil {
+		return err
+	}
+	iv, err := readHeader(r)
+	if err != nil {
+		return err
+	}
+	mac := hmac.New(sha512.New, key[cryptoAESKeySize:])
+	mac.Write(iv)
+	if _, err := io.CopyN(mac, r, plainSize); err != nil {
+		return fmt.Errorf("reading ciphertext: %v", err)
+	}
+	sum := make([]byte, cryptoMACSize)
+	if _, err := io.ReadFull(r, sum); err != nil {
+		return fmt.Errorf("reading hmac: %v", err)
+	}
+	if !hmac.Equal(sum, mac.Sum(nil)) {
+		return ErrCorruptCiphertext
+	}
+	return nil
+}
+
+// NewVerifiedRangeDecrypter is like NewRangeDecrypter, but the HMAC is not verified.
+// Only use it for ciphertext that has already been verified with VerifyCiphertext,
+// e.g., content-addressed data that can't change once verified.
+func NewVerifiedRangeDecrypter(r io.ReadSeeker, key []byte, size, offset int64) (io.Reader, error) {
+	if len(key) != cryptoKeySize {
+		return nil, fmt.Errorf("invalid key size")
+	}
+	plainSize, err := DecryptedSize(size)
+	if err != nil {
+		return nil, err
+	}
+	if offset < 0 || offset > plainSize {
+		return nil, ErrInvalidRange
+	}
+	iv, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key[:cryptoAESKeySize])
+	if err != nil {
+		return nil, err
+	}
+	start := offset / aes.BlockSize
+	if _, err := r.Seek(cryptoHeaderSize+start*aes.BlockSize, io.SeekStart); err != nil {
+		return nil, err
+	}
+	dr := &cipher.StreamReader{
+		S: cipher.NewCTR(block, addCounter(iv, uint64(start))),
+		R: io.LimitReader(r, plainSize-start*aes.BlockSize),
+	}
+	if _, err := io.CopyN(ioutil.Discard, dr, offset%aes.BlockSize); err != nil {
+		return nil, err
+	}
+	return dr, nil
+}
+
+// readHeader reads the header from the start of r, returning the IV.
+func readHeader(r io.ReadSeeker) ([]byte, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	header := make([]byte, cryptoHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("reading header: %v", err)
+	}
+	if v := binary.LittleEndian.Uint32(header[:cryptoVersionSize]); v != uint32(dcrypto.V1) {
+		return nil, fmt.Errorf("unsupported encryption version: %d", v)
+	}
+	return header[cryptoVersionSize:], nil
+}
+
+// addCounter returns a copy of the big-endian counter iv incremented by n.
+func addCounter(iv []byte, n uint64) []byte {
+	ctr := make([]byte, len(iv))
+	copy(ctr, iv)
+	for i := len(ctr) - 1; i >= 0 && n > 0; i-- {
+		sum := uint64(ctr[i]) + n&0xff
+		ctr[i] = byte(sum)
+		n = n>>8 + sum>>8
+	}
+	return ctr
+}
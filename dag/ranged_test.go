@@ -0,0 +1,89 @@
+package dag_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/dcrypto"
+	. "github.com/textileio/go-buckets/dag"
+)
+
+func TestNewRangeDecrypter(t *testing.T) {
+	plain := make([]byte, 1000)
+	_, err := rand.Read(plain)
+	require.NoError(t, err)
+	key, data := encrypt(t, plain)
+
+	size, err := DecryptedSize(int64(len(data)))
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(plain)), size)
+
+	for _, offset := range []int64{0, 1, 15, 16, 17, 500, 999, 1000} {
+		r, err := NewRangeDecrypter(bytes.NewReader(data), key, int64(len(data)), offset)
+		require.NoError(t, err)
+		got, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, plain[offset:], got, "offset %d", offset)
+	}
+
+	_, err = NewRangeDecrypter(bytes.NewReader(data), key, int64(len(data)), 1001)
+	require.ErrorIs(t, err, ErrInvalidRange)
+	_, err = NewRangeDecrypter(bytes.NewReader(data), key, int64(len(data)), -1)
+	require.ErrorIs(t, err, ErrInvalidRange)
+}
+
+func TestNewRangeDecrypter_Tampered(t *testing.T) {
+	plain := make([]byte, 1000)
+	_, err := rand.Read(plain)
+	require.NoError(t, err)
+	key, data := encrypt(t, plain)
+
+	// Ciphertext that fails verification is never decrypted, even outside the range
+	data[len(data)/2] ^= 1
+	_, err = NewRangeDecrypter(bytes.NewReader(data), key, int64(len(data)), 900)
+	require.ErrorIs(t, err, ErrCorruptCiphertext)
+
+	other, err := dcrypto.NewKey()
+	require.NoError(t, err)
+	_, data = encrypt(t, plain)
+	_, err = NewRangeDecrypter(bytes.NewReader(data), other, int64(len(data)), 0)
+	require.ErrorIs(t, err, ErrCorruptCiphertext)
+}
+
+func TestNewVerifiedRangeDecrypter(t *testing.T) {
+	plain := make([]byte, 1000)
+	_, err := rand.Read(plain)
+	require.NoError(t, err)
+	key, data := encrypt(t, plain)
+
+	r := bytes.NewReader(data)
+	err = VerifyCiphertext(r, key, int64(len(data)))
+	require.NoError(t, err)
+
+	// Verified ciphertext can be reopened at any offset without reading all of it again
+	for _, offset := range []int64{0, 17, 999} {
+		dr, err := NewVerifiedRangeDecrypter(r, key, int64(len(data)), offset)
+		require.NoError(t, err)
+		got, err := ioutil.ReadAll(dr)
+		require.NoError(t, err)
+		assert.Equal(t, plain[offset:], got, "offset %d", offset)
+	}
+
+	data[len(data)/2] ^= 1
+	err = VerifyCiphertext(bytes.NewReader(data), key, int64(len(data)))
+	require.ErrorIs(t, err, ErrCorruptCiphertext)
+}
+
+func encrypt(t *testing.T, plain []byte) ([]byte, []byte) {
+	key, err := dcrypto.NewKey()
+	require.NoError(t, err)
+	r, err := dcrypto.NewEncrypter(bytes.NewReader(plain), key)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	return key, data
+}
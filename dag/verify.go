@@ -0,0 +1,58 @@
+package dag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	mdag "github.com/ipfs/go-merkledag"
+	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+)
+
+// ErrBlockCorrupted indicates a block's data does not hash to its cid.
+var ErrBlockCorrupted = errors.New("block data does not match cid")
+
+// GetVerifiedNode fetches the raw block for c, confirms that its data hashes to c, and decodes it.
+// Unlike the dag API, the hash is checked here regardless of how ipfs validates blocks.
+func GetVerifiedNode(ctx context.Context, ipfs iface.CoreAPI, c cid.Cid) (ipld.Node, error) {
+	r, err := ipfs.Block().Get(ctx, path.IpfsPath(c))
+	if err != nil {
+		return nil, fmt.Errorf("getting block: %v", err)
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading block: %v", err)
+	}
+	return DecodeBlock(c, data)
+}
+
+// DecodeBlock confirms that data hashes to c and decodes it as a raw or dag-pb node.
+func DecodeBlock(c cid.Cid, data []byte) (ipld.Node, error) {
+	sum, err := c.Prefix().Sum(data)
+	if err != nil {
+		return nil, fmt.Errorf("hashing block: %v", err)
+	}
+	if !sum.Equals(c) {
+		return nil, ErrBlockCorrupted
+	}
+	switch c.Type() {
+	case cid.Raw:
+		return mdag.NewRawNodeWPrefix(data, c.Prefix())
+	case cid.DagProtobuf:
+		n, err := mdag.DecodeProtobuf(data)
+		if err != nil {
+			return nil, fmt.Errorf("decoding block: %v", err)
+		}
+		n.SetCidBuilder(c.Prefix())
+		if !n.Cid().Equals(c) {
+			return nil, fmt.Errorf("decoding block: non-canonical encoding")
+		}
+		return n, nil
+	default:
+		return nil, ErrInvalidNodeType
+	}
+}
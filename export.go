@@ -0,0 +1,228 @@
+package buckets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	c "github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	mdag "github.com/ipfs/go-merkledag"
+	"github.com/textileio/go-buckets/audit"
+	"github.com/textileio/go-buckets/car"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-buckets/util"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+const (
+	// ArchiveVersion is the current bucket archive format version.
+	ArchiveVersion = 1
+
+	// importBatchSize is the number of archive blocks added to IPFS at once.
+	importBatchSize = 128
+)
+
+// ArchiveManifest describes the bucket in an archive.
+type ArchiveManifest struct {
+	// Version is the archive format version.
+	Version int `json:"version"`
+	// Thread is the thread of the exported bucket.
+	Thread core.ID `json:"thread"`
+	// Bucket is the exported bucket instance, including encryption keys for private buckets.
+	// History is not included.
+	Bucket collection.Bucket `json:"bucket"`
+	// CreatedAt is the time the archive was created.
+	CreatedAt int64 `json:"created_at"`
+}
+
+// Export writes the current bucket dag to w as a CARv1 archive.
+// Private bucket blocks are written in their encrypted form.
+// The archive has two roots: a raw block containing the JSON encoded ArchiveManifest,
+// followed by the bucket root.
+// Exporting requires read access to the bucket root.
+// Archives of private buckets contain their encryption keys and should be handled with care.
+func (b *Buckets) Export(ctx context.Context, thread core.ID, key string, identity did.Token, w io.Writer) error {
+	instance, err := b.getView(ctx, thread, key, identity)
+	if err != nil {
+		return err
+	}
+	if _, _, ok := instance.GetMetadataForPath("", false); !ok {
+		return fmt.Errorf("permission denied")
+	}
+	root, err := util.NewResolvedPath(instance.Path)
+	if err != nil {
+		return fmt.Errorf("resolving path: %v", err)
+	}
+
+	manifest := ArchiveManifest{
+		Version:   ArchiveVersion,
+		Thread:    thread,
+		Bucket:    *instance,
+		CreatedAt: time.Now().UnixNano(),
+	}
+	manifest.Bucket.History = nil
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("encoding manifest: %v", err)
+	}
+	mn := mdag.NewRawNode(data)
+	cw, err := car.NewWriter(w, []c.Cid{mn.Cid(), root.Cid()})
+	if err != nil {
+		return err
+	}
+	if err := cw.Put(mn.Cid(), data); err != nil {
+		return err
+	}
+	if err := b.exportNode(ctx, cw, root.Cid(), instance.GetLinkEncryptionKey(), make(map[c.Cid]struct{})); err != nil {
+		return err
+	}
+
+	log.Debugf("exported %s", key)
+	return nil
+}
+
+// exportNode writes the block with cid id and all linked blocks.
+// Encrypted folders are decrypted with key to find their links.
+func (b *Buckets) exportNode(
+	ctx context.Context,
+	cw *car.Writer,
+	id c.Cid,
+	key []byte,
+	seen map[c.Cid]struct{},
+) error {
+	if _, ok := seen[id]; ok {
+		return nil
+	}
+	seen[id] = struct{}{}
+	n, err := dag.GetVerifiedNode(ctx, b.ipfs, id)
+	if err != nil {
+		return fmt.Errorf("getting node %s: %v", id, err)
+	}
+	if err := cw.Put(id, n.RawData()); err != nil {
+		return err
+	}
+	if key != nil {
+		dn, decrypted, err := dag.DecryptNode(n, key)
+		if err != nil {
+			return fmt.Errorf("decrypting node %s: %v", id, err)
+		}
+		if decrypted {
+			n = dn
+		}
+	}
+	for _, l := range n.Links() {
+		if err := b.exportNode(ctx, cw, l.Cid, key, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Import creates a new bucket in thread from an archive written by Export.
+// A new thread is created if thread is not defined.
+// The archived access roles and metadata attributes are restored, with the caller added as root admin.
+// Archived encryption keys are re-used unless new keys are requested with WithImportRegenerateKeys.
+// In both cases, the bucket seed is replaced, so the new bucket root is unique.
+func (b *Buckets) Import(
+	ctx context.Context,
+	thread core.ID,
+	identity did.Token,
+	r io.Reader,
+	opts ...ImportOption,
+) (*Bucket, int64, error) {
+	args := &ImportOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+
+	cr, err := car.NewReader(r)
+	if err != nil {
+		return nil, 0, fmt.Errorf("reading archive: %v", err)
+	}
+	if len(cr.Header.Roots) != 2 {
+		return nil, 0, fmt.Errorf("invalid archive: expected 2 roots, got %d", len(cr.Header.Roots))
+	}
+	mc, rc := cr.Header.Roots[0], cr.Header.Roots[1]
+
+	// Add archived blocks to IPFS. They are pinned once the new bucket is created.
+	var (
+		manifest *ArchiveManifest
+		hasRoot  bool
+		batch    = make([]ipld.Node, 0, importBatchSize)
+	)
+	for {
+		id, data, err := cr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, 0, fmt.Errorf("reading archive: %v", err)
+		}
+		n, err := dag.DecodeBlock(id, data)
+		if err != nil {
+			return nil, 0, fmt.Errorf("decoding block %s: %v", id, err)
+		}
+		if id.Equals(mc) {
+			manifest = &ArchiveManifest{}
+			if err := json.Unmarshal(data, manifest); err != nil {
+				return nil, 0, fmt.Errorf("decoding manifest: %v", err)
+			}
+			continue
+		}
+		if id.Equals(rc) {
+			hasRoot = true
+		}
+		batch = append(batch, n)
+		if len(batch) == importBatchSize {
+			if err := b.ipfs.Dag().AddMany(ctx, batch); err != nil {
+				return nil, 0, fmt.Errorf("adding blocks: %v", err)
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := b.ipfs.Dag().AddMany(ctx, batch); err != nil {
+		return nil, 0, fmt.Errorf("adding blocks: %v", err)
+	}
+
+	if manifest == nil {
+		return nil, 0, fmt.Errorf("invalid archive: missing manifest")
+	}
+	if manifest.Version != ArchiveVersion {
+		return nil, 0, fmt.Errorf("unsupported archive version: %d", manifest.Version)
+	}
+	if !hasRoot {
+		return nil, 0, fmt.Errorf("invalid archive: missing bucket root")
+	}
+	src := &manifest.Bucket
+	srcPath, err := util.NewResolvedPath(src.Path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("resolving path: %v", err)
+	}
+	if !srcPath.Cid().Equals(rc) {
+		return nil, 0, fmt.Errorf("invalid archive: manifest path does not match bucket root")
+	}
+	if args.Name == "" {
+		args.Name = src.Name
+	}
+
+	ctx, thread, instance, err := b.newBucketFromSource(ctx, thread, identity, src, copyArgs{
+		name:       args.Name,
+		private:    args.Private,
+		roles:      true,
+		attributes: true,
+		keys:       !args.RegenerateKeys,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	b.record(ctx, thread, instance.Key, instance.Owner, audit.OpImport, nil, "", instance.Path)
+
+	log.Debugf("imported %s to %s", src.Key, instance.Key)
+	return instanceToBucket(thread, instance), dag.GetPinnedBytes(ctx), nil
+}
@@ -0,0 +1,240 @@
+package buckets
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/textileio/dcrypto"
+	"github.com/textileio/go-buckets/audit"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-buckets/util"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/go-threads/db"
+)
+
+// Fork creates a new bucket from the current root of an existing bucket.
+// The new bucket is created in thread, which can be the source thread.
+// A new thread is created if thread is not defined.
+// The source dag is re-used without pulling any data to the client,
+// but it will be re-encrypted if the source or new bucket is private.
+func (b *Buckets) Fork(
+	ctx context.Context,
+	srcThread core.ID,
+	srcKey string,
+	thread core.ID,
+	identity did.Token,
+	opts ...ForkOption,
+) (*Bucket, int64, error) {
+	args := &ForkOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+
+	src, err := b.getView(ctx, srcThread, srcKey, identity)
+	if err != nil {
+		return nil, 0, err
+	}
+	if _, _, ok := src.GetMetadataForPath("", false); !ok {
+		return nil, 0, fmt.Errorf("permission denied")
+	}
+	if args.Name == "" {
+		args.Name = src.Name
+	}
+	ctx, thread, instance, err := b.newBucketFromSource(ctx, thread, identity, src, copyArgs{
+		name:    args.Name,
+		private: args.Private,
+		roles:   args.Roles,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	b.record(ctx, thread, instance.Key, instance.Owner, audit.OpFork, nil, "", instance.Path)
+
+	log.Debugf("forked %s to %s", srcKey, instance.Key)
+	return instanceToBucket(thread, instance), dag.GetPinnedBytes(ctx), nil
+}
+
+// copyArgs configures a new bucket created from a source bucket.
+type copyArgs struct {
+	name string
+	// private encrypts the new bucket. Copies of private buckets are always private.
+	private bool
+	// roles copies source access roles.
+	roles bool
+	// attributes copies source metadata attributes.
+	attributes bool
+	// keys re-uses the source encryption keys instead of creating new ones.
+	// Version 0 sources always get new keys.
+	keys bool
+}
+
+// newBucketFromSource creates a new bucket in thread from the current root of src.
+// A new thread is created if thread is not defined.
+// The source dag must be available to the IPFS node.
+func (b *Buckets) newBucketFromSource(
+	ctx context.Context,
+	thread core.ID,
+	identity did.Token,
+	src *collection.Bucket,
+	args copyArgs,
+) (context.Context, core.ID, *collection.Bucket, error) {
+	private := src.IsPrivate() || args.private
+	keepKeys := args.keys && src.IsPrivate() && src.Version > 0
+
+	if thread.Defined() {
+		if err := thread.Validate(); err != nil {
+			return ctx, thread, nil, fmt.Errorf("invalid thread id: %v", err)
+		}
+	} else {
+		thread = core.NewRandomIDV1()
+		if err := b.db.NewDB(ctx, thread, db.WithNewManagedName(args.name)); err != nil {
+			return ctx, thread, nil, fmt.Errorf("creating new thread: %v", err)
+		}
+	}
+
+	_, owner, err := b.net.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return ctx, thread, nil, fmt.Errorf("validating identity: %v", err)
+	}
+	ctx = dag.NewOwnerContext(ctx, owner)
+
+	// Create bucket keys if private
+	var linkKey, fileKey []byte
+	if keepKeys {
+		linkKey = src.GetLinkEncryptionKey()
+		fileKey, err = src.GetFileEncryptionKeyForPath("")
+		if err != nil {
+			return ctx, thread, nil, err
+		}
+	} else if private {
+		linkKey, err = dcrypto.NewKey()
+		if err != nil {
+			return ctx, thread, nil, err
+		}
+		fileKey, err = dcrypto.NewKey()
+		if err != nil {
+			return ctx, thread, nil, err
+		}
+	}
+
+	// Copy source metadata, creating new file keys and resetting roles as needed
+	now := time.Now()
+	md := make(map[string]collection.Metadata)
+	for p, m := range src.Metadata {
+		x := collection.Metadata{
+			Roles:     make(map[did.DID]collection.Role),
+			UpdatedAt: now.UnixNano(),
+		}
+		if args.roles {
+			for k, r := range m.Roles {
+				x.Roles[k] = r
+			}
+		}
+		if args.attributes && len(m.Attributes) != 0 {
+			x.Attributes = make(map[string]string, len(m.Attributes))
+			for k, v := range m.Attributes {
+				x.Attributes[k] = v
+			}
+		}
+		if keepKeys {
+			x.Key = m.Key
+		} else if private && m.Key != "" && p != "" {
+			k, err := dcrypto.NewKey()
+			if err != nil {
+				return ctx, thread, nil, err
+			}
+			x.SetFileEncryptionKey(k)
+		}
+		md[p] = x
+	}
+	if args.roles {
+		root := md[""]
+		root.SetFileEncryptionKey(fileKey)
+		root.Roles[owner] = collection.AdminRole
+		if private && !src.IsPrivate() {
+			delete(root.Roles, "*")
+		}
+		md[""] = root
+	} else {
+		root := collection.NewDefaultMetadata(owner, fileKey, now)
+		root.Attributes = md[""].Attributes
+		md[""] = root
+	}
+
+	// Make a random seed, which ensures a bucket's uniqueness
+	seed, err := dag.MakeBucketSeed(fileKey)
+	if err != nil {
+		return ctx, thread, nil, fmt.Errorf("making bucket seed: %v", err)
+	}
+
+	// Create the bucket directory from the source root
+	srcPath, err := util.NewResolvedPath(src.Path)
+	if err != nil {
+		return ctx, thread, nil, fmt.Errorf("resolving path: %v", err)
+	}
+	var currentFileKeys, newFileKeys map[string][]byte
+	if src.IsPrivate() {
+		currentFileKeys, err = src.GetFileEncryptionKeysForPrefix("")
+		if err != nil {
+			return ctx, thread, nil, err
+		}
+	}
+	if private {
+		tmp := &collection.Bucket{
+			Version:  int(collection.Version1),
+			LinkKey:  base64.StdEncoding.EncodeToString(linkKey),
+			Metadata: md,
+		}
+		newFileKeys, err = tmp.GetFileEncryptionKeysForPrefix("")
+		if err != nil {
+			return ctx, thread, nil, err
+		}
+	}
+	ctx, pth, err := dag.CreateBucketPathFromRoot(
+		ctx,
+		b.ipfs,
+		b.pinner,
+		srcPath,
+		src.GetLinkEncryptionKey(),
+		currentFileKeys,
+		linkKey,
+		newFileKeys,
+		fileKey,
+		seed,
+	)
+	if err != nil {
+		return ctx, thread, nil, fmt.Errorf("creating bucket from source: %v", err)
+	}
+
+	// Create a new IPNS key
+	key, err := b.ipns.CreateKey(ctx, thread)
+	if err != nil {
+		return ctx, thread, nil, fmt.Errorf("creating IPNS key: %v", err)
+	}
+
+	// Create the bucket using the IPNS key as instance ID
+	instance, err := b.c.New(
+		ctx,
+		thread,
+		key,
+		owner,
+		pth,
+		now,
+		md,
+		identity,
+		collection.WithBucketName(args.name),
+		collection.WithBucketKey(linkKey),
+	)
+	if err != nil {
+		return ctx, thread, nil, err
+	}
+
+	// Publish the new bucket's address to the name system
+	go b.ipns.Publish(pth, instance.Key)
+	return ctx, thread, instance, nil
+}
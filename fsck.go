@@ -0,0 +1,207 @@
+package buckets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	c "github.com/ipfs/go-cid"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-buckets/util"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+// FsckFetchTimeout limits the time spent fetching a single node while checking pins.
+var FsckFetchTimeout = time.Minute
+
+// FsckBucket describes a bucket that could not be fully checked.
+type FsckBucket struct {
+	Thread core.ID
+	Key    string
+	Reason string
+}
+
+// FsckReport describes discrepancies between bucket roots and pins.
+type FsckReport struct {
+	// Buckets is the number of buckets checked.
+	Buckets int
+	// Pins is the number of pins created by the pinner.
+	Pins int
+	// Incomplete lists buckets whose pins could not be fully determined.
+	Incomplete []FsckBucket
+	// Missing are cids that must be pinned to persist bucket data but are not.
+	Missing []c.Cid
+	// Orphaned are pinned cids that don't belong to any bucket.
+	// If any bucket is incomplete, some of these may belong to it.
+	Orphaned []c.Cid
+	// Pinned are the missing cids that were pinned in fix mode.
+	Pinned []c.Cid
+	// Unpinned are the orphaned cids that were unpinned in fix mode.
+	Unpinned []c.Cid
+	// Errors are repair failures in fix mode.
+	Errors []string
+}
+
+// Fsck compares the pins expected by all buckets with the pins created by the pinner.
+// Pinning and bucket saves are not transactional, so an interrupted update can leave
+// pins missing or orphaned.
+//
+// Bucket instances are read with the server identity, so private buckets can be walked.
+// Every restorable root is expected to be pinned. Private bucket nodes are also expected
+// to be pinned individually, since IPFS can't follow encrypted links. Pins of other nodes
+// that belong to a bucket dag, e.g., folders pinned by path updates, are allowed.
+// All other pins created by the pinner are reported as orphaned, including pins held by
+// in-progress upload sessions, so orphans should only be removed while the daemon is stopped.
+func (b *Buckets) Fsck(ctx context.Context, opts ...FsckOption) (*FsckReport, error) {
+	args := &FsckOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+
+	server, err := b.serverToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	all, err := b.c.ListAll(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+	report := &FsckReport{}
+	required := make(map[c.Cid]struct{})
+	allowed := make(map[c.Cid]struct{})
+	for thread, instances := range all {
+		for _, instance := range instances {
+			report.Buckets++
+			if err := b.fsckBucket(ctx, instance, required, allowed); err != nil {
+				report.Incomplete = append(report.Incomplete, FsckBucket{
+					Thread: thread,
+					Key:    instance.Key,
+					Reason: err.Error(),
+				})
+			}
+		}
+	}
+
+	pins, err := b.pinner.Ls(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing pins: %v", err)
+	}
+	report.Pins = len(pins)
+	pinned := make(map[c.Cid]struct{}, len(pins))
+	for _, p := range pins {
+		pinned[p] = struct{}{}
+		if _, ok := allowed[p]; !ok {
+			report.Orphaned = append(report.Orphaned, p)
+		}
+	}
+	for r := range required {
+		if _, ok := pinned[r]; !ok {
+			report.Missing = append(report.Missing, r)
+		}
+	}
+	sortCids(report.Orphaned)
+	sortCids(report.Missing)
+
+	if !args.Fix {
+		log.Debugf("checked %d buckets: %d missing, %d orphaned", report.Buckets, len(report.Missing), len(report.Orphaned))
+		return report, nil
+	}
+	for _, m := range report.Missing {
+		if err := b.pinner.Add(ctx, m); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("pinning %s: %v", m, err))
+			continue
+		}
+		report.Pinned = append(report.Pinned, m)
+	}
+	// Incomplete buckets may own some of the orphans
+	if len(report.Incomplete) == 0 {
+		for _, o := range report.Orphaned {
+			if err := b.pinner.Rm(ctx, o); err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("unpinning %s: %v", o, err))
+				continue
+			}
+			report.Unpinned = append(report.Unpinned, o)
+		}
+	}
+
+	log.Debugf("repaired %d buckets: %d pinned, %d unpinned", report.Buckets, len(report.Pinned), len(report.Unpinned))
+	return report, nil
+}
+
+// fsckBucket adds the cids that must be pinned for instance to required,
+// and the cids that belong to instance's restorable roots to allowed.
+func (b *Buckets) fsckBucket(
+	ctx context.Context,
+	instance *collection.Bucket,
+	required, allowed map[c.Cid]struct{},
+) error {
+	roots := []string{instance.Path}
+	for _, r := range instance.History {
+		if r.Pinned && r.Path != instance.Path {
+			roots = append(roots, r.Path)
+		}
+	}
+	linkKey := instance.GetLinkEncryptionKey()
+
+	var errs []error
+	seen := make(map[c.Cid]struct{})
+	for _, r := range roots {
+		p, err := util.NewResolvedPath(r)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("resolving path: %v", err))
+			continue
+		}
+		required[p.Cid()] = struct{}{}
+		allowed[p.Cid()] = struct{}{}
+		if err := b.fsckBranch(ctx, p, linkKey, required, allowed, seen); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) != 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// fsckBranch walks the dag at p, adding named nodes to allowed.
+// If key is not nil, named nodes are also added to required.
+func (b *Buckets) fsckBranch(
+	ctx context.Context,
+	p path.Resolved,
+	key []byte,
+	required, allowed, seen map[c.Cid]struct{},
+) error {
+	if _, ok := seen[p.Cid()]; ok {
+		return nil
+	}
+	seen[p.Cid()] = struct{}{}
+	allowed[p.Cid()] = struct{}{}
+	if key != nil {
+		required[p.Cid()] = struct{}{}
+	}
+
+	fctx, cancel := context.WithTimeout(ctx, FsckFetchTimeout)
+	n, _, err := dag.ResolveNodeAtPath(fctx, b.ipfs, p, key)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("resolving node %s: %v", p.Cid(), err)
+	}
+	for _, l := range n.Links() {
+		if l.Name == "" {
+			continue // Data nodes will never be pinned directly
+		}
+		if err := b.fsckBranch(ctx, path.IpfsPath(l.Cid), key, required, allowed, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortCids(cids []c.Cid) {
+	sort.Slice(cids, func(i, j int) bool {
+		return cids[i].String() < cids[j].String()
+	})
+}
@@ -0,0 +1,131 @@
+package buckets_test
+
+import (
+	"context"
+	"crypto/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	ipfsfiles "github.com/ipfs/go-ipfs-files"
+	httpapi "github.com/ipfs/go-ipfs-http-client"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/api/apitest"
+	"github.com/textileio/go-buckets/api/common"
+	"github.com/textileio/go-buckets/ipns"
+	"github.com/textileio/go-buckets/pinning"
+	"github.com/textileio/go-buckets/util"
+	dbc "github.com/textileio/go-threads/api/client"
+	"github.com/textileio/go-threads/core/did"
+	"github.com/textileio/go-threads/core/thread"
+	tdb "github.com/textileio/go-threads/db"
+	nc "github.com/textileio/go-threads/net/api/client"
+)
+
+func TestMain(m *testing.M) {
+	cleanup := func() {}
+	if os.Getenv("SKIP_SERVICES") != "true" {
+		cleanup = apitest.StartServices()
+	}
+	exitVal := m.Run()
+	cleanup()
+	os.Exit(exitVal)
+}
+
+func TestBuckets_Fsck(t *testing.T) {
+	ctx := context.Background()
+	ipfs, err := httpapi.NewApi(apitest.GetIPFSApiMultiAddr())
+	require.NoError(t, err)
+	pinner := pinning.NewIPFSPinner(ipfs, tdb.NewTxMapDatastore())
+	lib := newLib(t, pinner)
+	identity := newIdentity(t, lib)
+
+	// Private buckets can only be walked with the link key, which is hidden from other identities
+	buck, _, _, err := lib.Create(ctx, identity, buckets.WithPrivate(true))
+	require.NoError(t, err)
+	root, err := util.NewResolvedPath(buck.Path)
+	require.NoError(t, err)
+
+	report, err := lib.Fsck(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Buckets)
+	assert.Empty(t, report.Incomplete)
+	assert.Empty(t, report.Missing)
+	assert.Empty(t, report.Orphaned)
+
+	// Pins held by other users of the node are not checked
+	foreign, err := ipfs.Unixfs().Add(ctx, ipfsfiles.NewBytesFile([]byte("foreign")))
+	require.NoError(t, err)
+	orphan, err := ipfs.Unixfs().Add(ctx, ipfsfiles.NewBytesFile([]byte("orphan")), options.Unixfs.Pin(false))
+	require.NoError(t, err)
+	err = pinner.Add(ctx, orphan.Cid())
+	require.NoError(t, err)
+	err = pinner.Rm(ctx, root.Cid())
+	require.NoError(t, err)
+
+	report, err = lib.Fsck(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []cid.Cid{root.Cid()}, report.Missing)
+	assert.Equal(t, []cid.Cid{orphan.Cid()}, report.Orphaned)
+	assert.Empty(t, report.Pinned)
+	assert.Empty(t, report.Unpinned)
+
+	report, err = lib.Fsck(ctx, buckets.WithFsckFix(true))
+	require.NoError(t, err)
+	assert.Empty(t, report.Errors)
+	assert.Equal(t, []cid.Cid{root.Cid()}, report.Pinned)
+	assert.Equal(t, []cid.Cid{orphan.Cid()}, report.Unpinned)
+	pinned, err := pinner.IsPinned(ctx, foreign.Cid())
+	require.NoError(t, err)
+	assert.True(t, pinned)
+
+	report, err = lib.Fsck(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, report.Missing)
+	assert.Empty(t, report.Orphaned)
+
+	// Pins created before pins were recorded are adopted by seeding
+	legacy := pinning.NewIPFSPinner(ipfs, tdb.NewTxMapDatastore())
+	n, err := legacy.Seed(ctx)
+	require.NoError(t, err)
+	assert.NotZero(t, n)
+	pins, err := legacy.Ls(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, pins, root.Cid())
+	n, err = legacy.Seed(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func newLib(t *testing.T, pinner pinning.Pinner, opts ...buckets.BucketsOption) *buckets.Buckets {
+	threadsAddr := apitest.GetThreadsApiAddr()
+	net, err := nc.NewClient(threadsAddr, common.GetClientRPCOpts(threadsAddr)...)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, net.Close()) })
+	db, err := dbc.NewClient(threadsAddr, common.GetClientRPCOpts(threadsAddr)...)
+	require.NoError(t, err)
+	ipfs, err := httpapi.NewApi(apitest.GetIPFSApiMultiAddr())
+	require.NoError(t, err)
+	ipnsm, err := ipns.NewManager(tdb.NewTxMapDatastore(), ipfs)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, ipnsm.Close()) })
+	lib, err := buckets.NewBuckets(net, db, ipfs, pinner, ipnsm, nil, nil, opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, lib.Close()) })
+	return lib
+}
+
+func newIdentity(t *testing.T, lib *buckets.Buckets) did.Token {
+	doc, err := lib.Net().GetServices(context.Background())
+	require.NoError(t, err)
+	sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	identity, err := thread.NewLibp2pIdentity(sk).Token(doc.ID, time.Hour)
+	require.NoError(t, err)
+	return identity
+}
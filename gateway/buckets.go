@@ -17,6 +17,7 @@ import (
 	"github.com/textileio/go-buckets"
 	"github.com/textileio/go-buckets/collection"
 	"github.com/textileio/go-buckets/ipns"
+	"github.com/textileio/go-buckets/share"
 	"github.com/textileio/go-buckets/util"
 	"github.com/textileio/go-threads/core/did"
 	"github.com/textileio/go-threads/core/thread"
@@ -78,11 +79,49 @@ func (g *Gateway) renderBucketPath(
 	pth string,
 	token did.Token,
 ) {
-	rep, buck, err := g.lib.ListPath(ctx, threadID, id, pth, token)
+	shareID := c.Query("share")
+	var shared *share.Link
+	if shareID != "" {
+		var err error
+		shared, err = validateShare(g.shares, shareID, threadID, id, pth, false)
+		if err != nil {
+			renderError(c, http.StatusForbidden, err)
+			return
+		}
+		ctx = buckets.NewGrantContext(ctx, shareGrant(shared))
+		token = ""
+	}
+	rep, buck, _, err := g.lib.ListPath(ctx, threadID, id, pth, token)
 	if err != nil {
 		render404(c)
 		return
 	}
+	download := c.Query("download")
+	if shared != nil {
+		if download != "" {
+			// Archives are always served in full, so they count as one download up front
+			if _, err := validateShare(g.shares, shareID, threadID, id, pth, true); err != nil {
+				renderError(c, http.StatusForbidden, err)
+				return
+			}
+		} else if !rep.IsDir && !shared.CanDownload() {
+			renderError(c, http.StatusForbidden, share.ErrDownloadLimit)
+			return
+		}
+	}
+	if download != "" {
+		format, err := buckets.NewArchiveFormatFromString(download)
+		if err != nil {
+			renderError(c, http.StatusBadRequest, err)
+			return
+		}
+		name := path.Base(pth)
+		if strings.Trim(pth, "/") == "" {
+			name = buck.Key
+		}
+		serveArchive(c, ctx, g.lib, threadID, buck.Key, pth, token, name, format)
+		return
+	}
 	if !rep.IsDir {
 		r, err := g.lib.PullPath(ctx, threadID, buck.Key, pth, token)
 		if err != nil {
@@ -90,9 +129,11 @@ func (g *Gateway) renderBucketPath(
 			return
 		}
 		defer r.Close()
-		if _, err := io.Copy(c.Writer, r); err != nil {
-			render404(c)
-			return
+		n := serveFile(c, path.Base(pth), rep, r)
+		if shared != nil {
+			if _, err := g.shares.AddServed(shareID, pth, n, r.Size()); err != nil {
+				log.Errorf("counting share link download: %v", err)
+			}
 		}
 	} else {
 		var base string
@@ -101,12 +142,10 @@ func (g *Gateway) renderBucketPath(
 		} else {
 			base = path.Join("thread", threadID.String(), collection.Name)
 		}
+		query := linkQuery(token, shareID)
 		var links []link
 		for _, item := range rep.Items {
-			pth := path.Join(base, strings.Replace(item.Path, buck.Path, buck.Key, 1))
-			if token.Defined() {
-				pth += "?token=" + string(token)
-			}
+			pth := path.Join(base, strings.Replace(item.Path, buck.Path, buck.Key, 1)) + query
 			links = append(links, link{
 				Name:  item.Name,
 				Path:  pth,
@@ -121,9 +160,10 @@ func (g *Gateway) renderBucketPath(
 			name = buck.Key
 		}
 		root := strings.Replace(rep.Path, buck.Path, name, 1)
-		back := path.Dir(path.Join(base, strings.Replace(rep.Path, buck.Path, buck.Key, 1)))
-		if token.Defined() {
-			back += "?token=" + string(token)
+		back := path.Dir(path.Join(base, strings.Replace(rep.Path, buck.Path, buck.Key, 1))) + query
+		if shared != nil && strings.Trim(pth, "/") == shared.Path {
+			// Don't link above the shared path
+			back = ""
 		}
 		c.HTML(http.StatusOK, "/public/html/unixfs.gohtml", gin.H{
 			"Title":   "Index of /" + root,
@@ -136,16 +176,97 @@ func (g *Gateway) renderBucketPath(
 	}
 }
 
+// linkQuery returns the query string used to carry access to linked bucket paths.
+// Share links are passed on as they are, since they carry no identity token.
+func linkQuery(token did.Token, shareID string) string {
+	if shareID != "" {
+		return "?share=" + shareID
+	}
+	if token.Defined() {
+		return "?token=" + string(token)
+	}
+	return ""
+}
+
+// validateShare returns the share link for id if it's valid for the bucket path.
+// If download is true, a download is counted against the link's download limit.
+func validateShare(
+	shares *share.Store,
+	id string,
+	threadID thread.ID,
+	key, pth string,
+	download bool,
+) (*share.Link, error) {
+	if shares == nil {
+		return nil, fmt.Errorf("share links are not enabled")
+	}
+	link, err := shares.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if link.Thread != threadID || link.Key != key {
+		return nil, share.ErrNotFound
+	}
+	return shares.Validate(id, pth, download)
+}
+
+// shareGrant returns the access granted by a share link.
+func shareGrant(link *share.Link) buckets.Grant {
+	return buckets.Grant{
+		Issuer: link.Issuer,
+		Path:   link.Path,
+		Role:   link.Role,
+	}
+}
+
+// serveFile writes a bucket file, responding to range requests with partial content.
+// Private bucket files are decrypted starting from the block containing the range start.
+// It returns the number of bytes written to the response body.
+func serveFile(c *gin.Context, name string, item *buckets.PathItem, r buckets.PathReader) int64 {
+	c.Header("Etag", strconv.Quote(item.Cid))
+	http.ServeContent(c.Writer, c.Request, name, time.Unix(0, item.Metadata.UpdatedAt), r)
+	if n := c.Writer.Size(); n > 0 {
+		return int64(n)
+	}
+	return 0
+}
+
+// serveArchive writes the bucket path as a downloadable archive.
+// Once the archive has started, errors can only be reported by aborting the response.
+func serveArchive(
+	c *gin.Context,
+	ctx context.Context,
+	lib *buckets.Buckets,
+	threadID thread.ID,
+	key, pth string,
+	token did.Token,
+	name string,
+	format buckets.ArchiveFormat,
+) {
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": name + "." + format.String(),
+	}))
+	c.Status(http.StatusOK)
+	if err := lib.PullPathArchive(ctx, threadID, key, pth, token, c.Writer, format); err != nil {
+		log.Errorf("writing archive: %v", err)
+		_ = c.Error(err)
+		c.Abort()
+	}
+}
+
 type serveBucketFS interface {
 	GetThread(key string) (thread.ID, error)
 	Exists(ctx context.Context, threadID thread.ID, bucket, pth string, token did.Token) (bool, string)
 	Write(ctx context.Context, threadID thread.ID, bucket, pth string, token did.Token, writer io.Writer) error
+	Share(threadID thread.ID, bucket, pth, id string, download bool) (*share.Link, error)
 	ValidHost() string
 }
 
 type bucketFS struct {
 	lib    *buckets.Buckets
 	ipns   *ipns.Manager
+	shares *share.Store
 	domain string
 }
 
@@ -159,11 +280,29 @@ func serveBucket(fs serveBucketFS) gin.HandlerFunc {
 		if err != nil {
 			return
 		}
-		token := did.Token(c.Query("token"))
-
 		ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
 		defer cancel()
+		token := did.Token(c.Query("token"))
+		shareID := c.Query("share")
+		if shareID != "" {
+			link, err := fs.Share(threadID, key, c.Request.URL.Path, shareID, false)
+			if err != nil {
+				renderError(c, http.StatusForbidden, err)
+				c.Abort()
+				return
+			}
+			ctx = buckets.NewGrantContext(ctx, shareGrant(link))
+			token = ""
+		}
 		exists, target := fs.Exists(ctx, threadID, key, c.Request.URL.Path, token)
+		// Files are always written in full here, so they count as one download up front
+		if shareID != "" && (exists || target != "") {
+			if _, err := fs.Share(threadID, key, c.Request.URL.Path, shareID, true); err != nil {
+				renderError(c, http.StatusForbidden, err)
+				c.Abort()
+				return
+			}
+		}
 		if exists {
 			c.Writer.WriteHeader(http.StatusOK)
 			ctype := mime.TypeByExtension(filepath.Ext(c.Request.URL.Path))
@@ -202,7 +341,7 @@ func (f *bucketFS) Exists(ctx context.Context, threadID thread.ID, key, pth stri
 	if key == "" || pth == "/" {
 		return
 	}
-	rep, _, err := f.lib.ListPath(ctx, threadID, key, pth, token)
+	rep, _, _, err := f.lib.ListPath(ctx, threadID, key, pth, token)
 	if err != nil {
 		return
 	}
@@ -227,6 +366,10 @@ func (f *bucketFS) Write(ctx context.Context, threadID thread.ID, key, pth strin
 	return err
 }
 
+func (f *bucketFS) Share(threadID thread.ID, key, pth, id string, download bool) (*share.Link, error) {
+	return validateShare(f.shares, id, threadID, key, pth, download)
+}
+
 func (f *bucketFS) ValidHost() string {
 	return f.domain
 }
@@ -241,7 +384,7 @@ func (g *Gateway) renderWWWBucket(c *gin.Context, key string) {
 	ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
 	defer cancel()
 	token := did.Token(c.Query("token"))
-	rep, _, err := g.lib.ListPath(ctx, ipnskey.ThreadID, key, "", token)
+	rep, _, _, err := g.lib.ListPath(ctx, ipnskey.ThreadID, key, "", token)
 	if err != nil {
 		render404(c)
 		return
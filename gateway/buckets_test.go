@@ -0,0 +1,88 @@
+package gateway
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/share"
+	"github.com/textileio/go-threads/core/thread"
+	tdb "github.com/textileio/go-threads/db"
+)
+
+func TestServeFile_Range(t *testing.T) {
+	data := []byte("0123456789")
+	item := &buckets.PathItem{Cid: "bafy", Name: "file.txt", Size: int64(len(data))}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/file.txt", nil)
+	c.Request.Header.Set("Range", "bytes=2-5")
+	serveFile(c, "file.txt", item, newTestReader(data))
+	assert.Equal(t, http.StatusPartialContent, w.Code)
+	assert.Equal(t, "bytes 2-5/10", w.Header().Get("Content-Range"))
+	assert.Equal(t, "2345", w.Body.String())
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/file.txt", nil)
+	serveFile(c, "file.txt", item, newTestReader(data))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("Content-Range"))
+	assert.Equal(t, string(data), w.Body.String())
+}
+
+func TestServeFile_RangeDownloads(t *testing.T) {
+	data := []byte("0123456789")
+	item := &buckets.PathItem{Cid: "bafy", Name: "file.txt", Size: int64(len(data))}
+	store := tdb.NewTxMapDatastore()
+	defer store.Close()
+	shares := share.NewStore(store)
+	l, err := shares.Create(thread.NewRandomIDV1(), "key", "", "did:key:foo", collection.ReaderRole, time.Hour, 1)
+	require.NoError(t, err)
+
+	serve := func(rng string) *share.Link {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/file.txt", nil)
+		c.Request.Header.Set("Range", rng)
+		r := newTestReader(data)
+		n := serveFile(c, "file.txt", item, r)
+		require.Equal(t, http.StatusPartialContent, w.Code)
+		require.Equal(t, int64(w.Body.Len()), n)
+		got, err := shares.AddServed(l.ID, "file.txt", n, r.Size())
+		require.NoError(t, err)
+		return got
+	}
+
+	// Suffix ranges are counted
+	got := serve("bytes=-4")
+	assert.Equal(t, 0, got.Downloads)
+	assert.True(t, got.CanDownload())
+
+	// Ranges that don't start at the first byte are counted
+	got = serve("bytes=3-5")
+	assert.Equal(t, 0, got.Downloads)
+	got = serve("bytes=6-")
+	assert.Equal(t, 1, got.Downloads)
+	assert.False(t, got.CanDownload())
+}
+
+type testReader struct {
+	*bytes.Reader
+}
+
+func newTestReader(data []byte) *testReader {
+	return &testReader{Reader: bytes.NewReader(data)}
+}
+
+func (r *testReader) Close() error {
+	return nil
+}
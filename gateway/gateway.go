@@ -23,6 +23,7 @@ import (
 	gincors "github.com/rs/cors/wrapper/gin"
 	"github.com/textileio/go-buckets"
 	"github.com/textileio/go-buckets/ipns"
+	"github.com/textileio/go-buckets/share"
 	"github.com/textileio/go-threads/core/thread"
 )
 
@@ -48,6 +49,7 @@ type Gateway struct {
 	lib    *buckets.Buckets
 	ipfs   iface.CoreAPI
 	ipns   *ipns.Manager
+	shares *share.Store
 
 	addr       string
 	url        string
@@ -61,6 +63,8 @@ type Config struct {
 	URL        string
 	Domain     string
 	Subdomains bool
+	// Shares enables share link access with the share query param.
+	Shares *share.Store
 }
 
 // NewGateway returns a new gateway.
@@ -69,6 +73,7 @@ func NewGateway(lib *buckets.Buckets, ipfs iface.CoreAPI, ipns *ipns.Manager, co
 		lib:        lib,
 		ipfs:       ipfs,
 		ipns:       ipns,
+		shares:     conf.Shares,
 		addr:       conf.Addr,
 		url:        conf.URL,
 		domain:     conf.Domain,
@@ -91,6 +96,7 @@ func (g *Gateway) Start() {
 	router.Use(serveBucket(&bucketFS{
 		lib:    g.lib,
 		ipns:   g.ipns,
+		shares: g.shares,
 		domain: g.domain,
 	}))
 	router.Use(gincors.New(cors.Options{}))
@@ -0,0 +1,105 @@
+package buckets
+
+import (
+	"context"
+	"errors"
+	gopath "path"
+	"strings"
+
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+// ErrNotGranted indicates a path is outside of a grant or the issuer no longer has the granted role.
+var ErrNotGranted = errors.New("access not granted")
+
+// Grant is access to a bucket path that an issuer has delegated, e.g., with a share link.
+// Reads made with a grant use the server identity instead of the issuer's identity token,
+// so they are limited to Path and its descendants,
+// and only succeed while the issuer still has at least Role at Path.
+type Grant struct {
+	// Issuer is the identity that delegated access.
+	Issuer did.DID
+	// Path is the granted path. Descendants of a granted folder are also granted.
+	Path string
+	// Role is the granted role.
+	Role collection.Role
+}
+
+type ctxGrantKey struct{}
+
+// NewGrantContext returns a context that reads bucket paths with grant.
+// The identity token passed to read calls is ignored.
+func NewGrantContext(ctx context.Context, grant Grant) context.Context {
+	return context.WithValue(ctx, ctxGrantKey{}, grant)
+}
+
+func grantFromContext(ctx context.Context) (Grant, bool) {
+	grant, ok := ctx.Value(ctxGrantKey{}).(Grant)
+	return grant, ok
+}
+
+// getBucketWithGrant returns a bucket instance read with the server identity on behalf of grant.
+func (b *Buckets) getBucketWithGrant(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	grant Grant,
+) (*collection.Bucket, error) {
+	if grant.Issuer == "" || grant.Role == collection.NoneRole {
+		return nil, ErrNotGranted
+	}
+	gpth := cleanGrantPath(grant.Path)
+	pth = cleanGrantPath(pth)
+	if gpth != "" && pth != gpth && !strings.HasPrefix(pth, gpth+"/") {
+		return nil, ErrNotGranted
+	}
+	instance, err := b.getWithServer(ctx, thread, key)
+	if err != nil {
+		return nil, err
+	}
+	server, err := b.serverToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := b.groupsOf(ctx, thread, grant.Issuer, server)
+	if err != nil {
+		return nil, err
+	}
+	if effectiveRole(instance, gpth, grant.Issuer, groups) < grant.Role {
+		return nil, ErrNotGranted
+	}
+	return instance, nil
+}
+
+// effectiveRole returns the most privileged role id has at pth, resolved as it is by the bucket collection.
+// groups are the groups id is a member of, keyed by name, with their owners.
+func effectiveRole(
+	instance *collection.Bucket,
+	pth string,
+	id did.DID,
+	groups map[string]did.DID,
+) collection.Role {
+	if instance.Owner == "" || instance.Owner == id {
+		return collection.AdminRole
+	}
+	effective := collection.NoneRole
+	for _, p := range pathAncestors(pth) {
+		md, ok := instance.Metadata[p]
+		if !ok {
+			continue
+		}
+		for principal, r := range md.Roles {
+			if role, ok := grantedRole(instance, principal, r, id, groups); ok && role > effective {
+				effective = role
+			}
+		}
+	}
+	return effective
+}
+
+// cleanGrantPath returns pth relative to the bucket root.
+func cleanGrantPath(pth string) string {
+	return trimSlash(gopath.Clean("/" + pth))
+}
@@ -0,0 +1,67 @@
+package buckets_test
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	httpapi "github.com/ipfs/go-ipfs-http-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/api/apitest"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/pinning"
+	"github.com/textileio/go-threads/core/did"
+	tdb "github.com/textileio/go-threads/db"
+)
+
+func TestBuckets_Grant(t *testing.T) {
+	ctx := context.Background()
+	ipfs, err := httpapi.NewApi(apitest.GetIPFSApiMultiAddr())
+	require.NoError(t, err)
+	lib := newLib(t, pinning.NewIPFSPinner(ipfs, tdb.NewTxMapDatastore()))
+	owner := newIdentity(t, lib)
+	reader := newIdentity(t, lib)
+	issuer, err := lib.ValidateIdentity(ctx, reader)
+	require.NoError(t, err)
+
+	buck, _, _, err := lib.Create(ctx, owner, buckets.WithPrivate(true))
+	require.NoError(t, err)
+	_, _, err = lib.Batch(ctx, buck.Thread, buck.Key, []buckets.BatchOp{
+		{Type: buckets.BatchPutPath, Path: "one/file.txt", Data: []byte("one")},
+		{Type: buckets.BatchPutPath, Path: "two/file.txt", Data: []byte("two")},
+	}, nil, owner)
+	require.NoError(t, err)
+	_, _, err = lib.PushPathAccessRoles(ctx, buck.Thread, buck.Key, "one", map[did.DID]collection.Role{
+		issuer: collection.ReaderRole,
+	}, nil, owner)
+	require.NoError(t, err)
+
+	// Granted paths are read without an identity token
+	gctx := buckets.NewGrantContext(ctx, buckets.Grant{
+		Issuer: issuer,
+		Path:   "one",
+		Role:   collection.ReaderRole,
+	})
+	r, err := lib.PullPath(gctx, buck.Thread, buck.Key, "one/file.txt", "")
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "one", string(data))
+
+	// Paths outside of the grant are not readable
+	_, _, _, err = lib.ListPath(gctx, buck.Thread, buck.Key, "two", "")
+	require.ErrorIs(t, err, buckets.ErrNotGranted)
+	_, _, _, err = lib.ListPath(gctx, buck.Thread, buck.Key, "one/../two", "")
+	require.ErrorIs(t, err, buckets.ErrNotGranted)
+
+	// Grants stop working when the issuer loses access
+	_, _, err = lib.PushPathAccessRoles(ctx, buck.Thread, buck.Key, "one", map[did.DID]collection.Role{
+		issuer: collection.NoneRole,
+	}, nil, owner)
+	require.NoError(t, err)
+	_, _, _, err = lib.ListPath(gctx, buck.Thread, buck.Key, "one", "")
+	require.ErrorIs(t, err, buckets.ErrNotGranted)
+}
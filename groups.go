@@ -0,0 +1,466 @@
+package buckets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/go-threads/db"
+)
+
+// Group adds thread ID to collection.Group.
+type Group struct {
+	Thread core.ID `json:"thread"`
+	collection.Group
+}
+
+// CreateGroup creates a named group of identities in a thread.
+// The group can be granted access roles on bucket paths with the principal collection.GroupPrincipal(name).
+// The caller becomes the group owner, who is the only identity allowed to manage members.
+func (b *Buckets) CreateGroup(
+	ctx context.Context,
+	thread core.ID,
+	name string,
+	members []did.DID,
+	identity did.Token,
+) (*Group, error) {
+	if err := collection.ValidateGroupName(name); err != nil {
+		return nil, err
+	}
+	owner, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	lk := b.locks.Get(groupLock(thread, name))
+	lk.Acquire()
+	defer lk.Release()
+
+	now := time.Now().UnixNano()
+	group := &collection.Group{
+		Name:      name,
+		Owner:     owner,
+		Members:   []did.DID{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	group.AddMembers(members...)
+	if _, err := b.g.Create(ctx, thread, group, collection.WithIdentity(identity)); err != nil {
+		return nil, fmt.Errorf("creating group: %v", err)
+	}
+
+	log.Debugf("created group %s in %s", name, thread)
+	return &Group{Thread: thread, Group: *group}, nil
+}
+
+// GetGroup returns a group by name.
+func (b *Buckets) GetGroup(ctx context.Context, thread core.ID, name string, identity did.Token) (*Group, error) {
+	group, err := b.g.GetSafe(ctx, thread, name, collection.WithIdentity(identity))
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("got group %s in %s", name, thread)
+	return &Group{Thread: thread, Group: *group}, nil
+}
+
+// ListGroups returns all groups in a thread.
+func (b *Buckets) ListGroups(ctx context.Context, thread core.ID, identity did.Token) ([]Group, error) {
+	list, err := b.g.List(ctx, thread, &db.Query{}, &collection.Group{}, collection.WithIdentity(identity))
+	if err != nil {
+		return nil, fmt.Errorf("listing groups: %v", err)
+	}
+	instances := list.([]*collection.Group)
+	groups := make([]Group, len(instances))
+	for i, in := range instances {
+		groups[i] = Group{Thread: thread, Group: *in}
+	}
+
+	log.Debugf("listed groups in %s", thread)
+	return groups, nil
+}
+
+// AddGroupMembers adds members to a group.
+// Only the group owner can add members.
+func (b *Buckets) AddGroupMembers(
+	ctx context.Context,
+	thread core.ID,
+	name string,
+	members []did.DID,
+	identity did.Token,
+) (*Group, error) {
+	return b.updateGroup(ctx, thread, name, identity, func(g *collection.Group) {
+		g.AddMembers(members...)
+	})
+}
+
+// RemoveGroupMembers removes members from a group.
+// Only the group owner can remove members.
+func (b *Buckets) RemoveGroupMembers(
+	ctx context.Context,
+	thread core.ID,
+	name string,
+	members []did.DID,
+	identity did.Token,
+) (*Group, error) {
+	return b.updateGroup(ctx, thread, name, identity, func(g *collection.Group) {
+		g.RemoveMembers(members...)
+	})
+}
+
+// DeleteGroup deletes a group.
+// Only the group owner can delete a group.
+// Access roles granted to the group no longer apply to its former members,
+// nor to the members of a new group with the same name unless the new group has the same owner.
+func (b *Buckets) DeleteGroup(ctx context.Context, thread core.ID, name string, identity did.Token) error {
+	lk := b.locks.Get(groupLock(thread, name))
+	lk.Acquire()
+	defer lk.Release()
+
+	if err := b.g.Delete(ctx, thread, name, collection.WithIdentity(identity)); err != nil {
+		return fmt.Errorf("deleting group: %v", err)
+	}
+
+	log.Debugf("deleted group %s in %s", name, thread)
+	return nil
+}
+
+func (b *Buckets) updateGroup(
+	ctx context.Context,
+	thread core.ID,
+	name string,
+	identity did.Token,
+	update func(g *collection.Group),
+) (*Group, error) {
+	owner, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	lk := b.locks.Get(groupLock(thread, name))
+	lk.Acquire()
+	defer lk.Release()
+
+	group, err := b.g.GetSafe(ctx, thread, name, collection.WithIdentity(identity))
+	if err != nil {
+		return nil, err
+	}
+	if group.Owner != owner {
+		return nil, fmt.Errorf("permission denied")
+	}
+	update(group)
+	group.UpdatedAt = time.Now().UnixNano()
+	if err := b.g.Save(ctx, thread, group, collection.WithIdentity(identity)); err != nil {
+		return nil, fmt.Errorf("saving group: %v", err)
+	}
+
+	log.Debugf("updated group %s in %s", name, thread)
+	return &Group{Thread: thread, Group: *group}, nil
+}
+
+// resolveGroups references the groups in roles with their current owners.
+// Groups that are no longer granted a role are removed.
+func (b *Buckets) resolveGroups(
+	ctx context.Context,
+	thread core.ID,
+	instance *collection.Bucket,
+	roles map[did.DID]collection.Role,
+	identity did.Token,
+) error {
+	for p, r := range roles {
+		name, ok := collection.GroupFromPrincipal(p)
+		if !ok || r == collection.NoneRole {
+			continue
+		}
+		group, err := b.g.GetSafe(ctx, thread, name, collection.WithIdentity(identity))
+		if err != nil {
+			return fmt.Errorf("group %s not found", name)
+		}
+		instance.SetGroup(name, group.Owner)
+	}
+	instance.PruneGroups()
+	return nil
+}
+
+func groupLock(thread core.ID, name string) lock {
+	return lock(thread.String() + "/" + collection.GroupPrefix + name)
+}
+
+// groupsOf returns the groups in thread that id is a member of, keyed by name, with their owners.
+// identity is used to list groups, which are readable by any identity.
+func (b *Buckets) groupsOf(
+	ctx context.Context,
+	thread core.ID,
+	id did.DID,
+	identity did.Token,
+) (map[string]did.DID, error) {
+	list, err := b.g.List(ctx, thread, &db.Query{}, &collection.Group{}, collection.WithIdentity(identity))
+	if err != nil {
+		return nil, fmt.Errorf("listing groups: %v", err)
+	}
+	groups := make(map[string]did.DID)
+	for _, g := range list.([]*collection.Group) {
+		if g.HasMember(id) {
+			groups[g.Name] = g.Owner
+		}
+	}
+	return groups, nil
+}
+
+// member is an identity's access to a bucket through the groups it's a member of.
+// Buckets only reference the groups that are granted roles, so the bucket collection can't check roles
+// granted to groups. Instead, members read and save the bucket with the server identity, and their roles
+// are checked here against the groups collection.
+type member struct {
+	id     did.DID
+	groups map[string]did.DID
+	thread core.ID
+	// original is the instance as it was read.
+	original *collection.Bucket
+}
+
+type ctxMemberKey struct{}
+
+// getSafe returns a bucket instance for identity.
+// If identity is a member of a group that the bucket grants a role to, the full instance is read
+// with the server identity, and the returned context carries identity's membership.
+// Instances read with such a context must be saved with save and filtered with view before
+// they are returned to the caller.
+func (b *Buckets) getSafe(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	identity did.Token,
+) (context.Context, *collection.Bucket, error) {
+	m, err := b.getMember(ctx, thread, key, identity)
+	if err != nil {
+		return ctx, nil, err
+	}
+	if m == nil {
+		instance, err := b.c.GetSafe(ctx, thread, key, collection.WithIdentity(identity))
+		return ctx, instance, err
+	}
+	instance, err := copyBucket(m.original)
+	if err != nil {
+		return ctx, nil, err
+	}
+	return context.WithValue(ctx, ctxMemberKey{}, m), instance, nil
+}
+
+// getView returns a bucket instance as identity can read it, including paths readable through groups.
+func (b *Buckets) getView(ctx context.Context, thread core.ID, key string, identity did.Token) (*collection.Bucket, error) {
+	ctx, instance, err := b.getSafe(ctx, thread, key, identity)
+	if err != nil {
+		return nil, err
+	}
+	return view(ctx, thread, instance), nil
+}
+
+// getMember returns identity's membership for the bucket,
+// or nil if identity isn't a member of any group the bucket grants a role to.
+func (b *Buckets) getMember(ctx context.Context, thread core.ID, key string, identity did.Token) (*member, error) {
+	if !identity.Defined() {
+		return nil, nil
+	}
+	id, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := b.groupsOf(ctx, thread, id, identity)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	instance, err := b.getWithServer(ctx, thread, key)
+	if err != nil {
+		return nil, err
+	}
+	if instance.Owner == "" || instance.Owner == id {
+		return nil, nil
+	}
+	m := &member{
+		id:       id,
+		groups:   groups,
+		thread:   thread,
+		original: instance,
+	}
+	for name := range instance.GroupOwners {
+		if m.inGroup(instance, name) {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
+// getWithServer returns a bucket instance read with the server identity.
+func (b *Buckets) getWithServer(ctx context.Context, thread core.ID, key string) (*collection.Bucket, error) {
+	server, err := b.serverToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := b.c.EnsureConfig(ctx, thread, server); err != nil {
+		return nil, err
+	}
+	return b.c.GetSafe(ctx, thread, key, collection.WithIdentity(server))
+}
+
+// memberFromContext returns the membership carried by ctx if it applies to instance.
+func memberFromContext(ctx context.Context, thread core.ID, instance *collection.Bucket) (*member, bool) {
+	m, ok := ctx.Value(ctxMemberKey{}).(*member)
+	if !ok || m.thread != thread || m.original.Key != instance.Key {
+		return nil, false
+	}
+	return m, true
+}
+
+// inGroup returns whether or not m is a member of the group referenced by instance as name.
+// The reference only applies to the group if it still has the referenced owner.
+func (m *member) inGroup(instance *collection.Bucket, name string) bool {
+	owner, ok := m.groups[name]
+	return ok && instance.GroupOwners[name] == owner
+}
+
+// view returns instance as m can read it, mirroring the bucket collection's read filter.
+func (m *member) view(instance *collection.Bucket) *collection.Bucket {
+	v := instance.Copy()
+	if v.Version == 0 || v.Owner == "" {
+		return v
+	}
+	v.Metadata = make(map[string]collection.Metadata)
+	for pth, md := range instance.Metadata {
+		if effectiveRole(instance, pth, m.id, m.groups) > collection.NoneRole {
+			v.Metadata[pth] = md
+		}
+	}
+	for i, r := range v.History {
+		if r.Keys == nil {
+			continue
+		}
+		keys := make(map[string]string)
+		for pth, k := range r.Keys {
+			if effectiveRole(instance, pth, m.id, m.groups) > collection.NoneRole {
+				keys[pth] = k
+			}
+		}
+		v.History[i].Keys = keys
+	}
+	if len(v.Metadata) == 0 {
+		v.LinkKey = ""
+	}
+	return v
+}
+
+// check returns an error if m isn't allowed to make the changes to instance,
+// mirroring the bucket collection's write validator.
+func (m *member) check(instance *collection.Bucket) error {
+	prev := m.original
+	if prev.Version == 0 || prev.Owner == "" {
+		return nil
+	}
+	if instance.Owner != prev.Owner ||
+		instance.Name != prev.Name ||
+		instance.Version != prev.Version ||
+		instance.LinkKey != prev.LinkKey ||
+		instance.CreatedAt != prev.CreatedAt ||
+		!reflect.DeepEqual(groupOwners(instance), groupOwners(prev)) {
+		return fmt.Errorf("permission denied")
+	}
+	var changed bool
+	for pth, md := range instance.Metadata {
+		x, ok := prev.Metadata[pth]
+		if ok && reflect.DeepEqual(x, md) {
+			continue
+		}
+		changed = true
+		if !ok {
+			return fmt.Errorf("permission denied") // no owner access to create items
+		}
+		role := effectiveRole(prev, pth, m.id, m.groups)
+		if !reflect.DeepEqual(x.Roles, md.Roles) && role < collection.AdminRole {
+			return fmt.Errorf("permission denied") // no admin access to edit roles
+		}
+		if role < collection.WriterRole {
+			return fmt.Errorf("permission denied") // no write access
+		}
+	}
+	for pth := range prev.Metadata {
+		if _, ok := instance.Metadata[pth]; ok {
+			continue
+		}
+		changed = true
+		if effectiveRole(prev, pth, m.id, m.groups) < collection.AdminRole {
+			return fmt.Errorf("permission denied") // no admin access to delete items
+		}
+	}
+	if !changed && instance.Path != prev.Path {
+		return fmt.Errorf("permission denied")
+	}
+	return nil
+}
+
+// save saves instance with identity.
+// If ctx carries a group membership for instance, the changes are checked against the member's roles
+// and saved with the server identity.
+func (b *Buckets) save(ctx context.Context, thread core.ID, instance *collection.Bucket, identity did.Token) error {
+	m, ok := memberFromContext(ctx, thread, instance)
+	if !ok {
+		return b.c.Save(ctx, thread, instance, collection.WithIdentity(identity))
+	}
+	if err := m.check(instance); err != nil {
+		return err
+	}
+	server, err := b.serverToken(ctx)
+	if err != nil {
+		return err
+	}
+	if err := b.c.Save(ctx, thread, instance, collection.WithIdentity(server)); err != nil {
+		return err
+	}
+	m.original, err = copyBucket(instance)
+	return err
+}
+
+// verify verifies the changes to instance as save would save them.
+func (b *Buckets) verify(ctx context.Context, thread core.ID, instance *collection.Bucket, identity did.Token) error {
+	m, ok := memberFromContext(ctx, thread, instance)
+	if !ok {
+		return b.c.Verify(ctx, thread, instance, collection.WithIdentity(identity))
+	}
+	return m.check(instance)
+}
+
+// view returns instance as the caller can read it.
+// Instances read for group members with getSafe are filtered to what the member can read.
+func view(ctx context.Context, thread core.ID, instance *collection.Bucket) *collection.Bucket {
+	if m, ok := memberFromContext(ctx, thread, instance); ok {
+		return m.view(instance)
+	}
+	return instance
+}
+
+// groupOwners returns the group references of instance, treating no references as empty.
+func groupOwners(instance *collection.Bucket) map[string]did.DID {
+	if len(instance.GroupOwners) == 0 {
+		return map[string]did.DID{}
+	}
+	return instance.GroupOwners
+}
+
+// copyBucket returns a deep copy of instance.
+func copyBucket(instance *collection.Bucket) (*collection.Bucket, error) {
+	data, err := json.Marshal(instance)
+	if err != nil {
+		return nil, fmt.Errorf("copying bucket: %v", err)
+	}
+	var c collection.Bucket
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("copying bucket: %v", err)
+	}
+	return &c, nil
+}
@@ -2,29 +2,84 @@ package buckets
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets/dag"
 	"github.com/textileio/go-threads/core/did"
 	core "github.com/textileio/go-threads/core/thread"
 )
 
+// ListPath returns the item at path, including nested items up to the depth option.
+// If a limit option is set, the returned cursor can be used to list the next page of items.
+// An empty cursor indicates there are no more items.
 func (b *Buckets) ListPath(
 	ctx context.Context,
 	thread core.ID,
 	key, pth string,
 	identity did.Token,
-) (*PathItem, *Bucket, error) {
+	opts ...ListPathOption,
+) (*PathItem, *Bucket, string, error) {
 	pth = trimSlash(pth)
 	instance, bpth, err := b.getBucketAndPath(ctx, thread, key, pth, identity)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, "", err
 	}
-	item, err := b.listPath(ctx, instance, bpth)
+	item, next, err := b.listPath(ctx, instance, bpth, opts...)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, "", err
 	}
 	log.Debugf("listed %s in %s", pth, key)
-	return item, instanceToBucket(thread, instance), nil
+	return item, instanceToBucket(thread, instance), next, nil
+}
+
+// WalkPath calls fn for each item below path in depth-first name order, up to the depth option.
+// If path is a file, fn is called once for the file.
+// Items are passed without nested items. rel is the item path relative to the bucket root,
+// which can be used as a cursor to resume the walk.
+func (b *Buckets) WalkPath(
+	ctx context.Context,
+	thread core.ID,
+	key, pth string,
+	identity did.Token,
+	fn func(rel string, item *PathItem) error,
+	opts ...ListPathOption,
+) error {
+	args := newListPathOptions(opts)
+	pth = trimSlash(pth)
+	instance, bpth, err := b.getBucketAndPath(ctx, thread, key, pth, identity)
+	if err != nil {
+		return err
+	}
+	linkKey := instance.GetLinkEncryptionKey()
+	n, err := dag.GetNodeAtPath(ctx, b.ipfs, bpth, linkKey)
+	if err != nil {
+		return err
+	}
+
+	var count int
+	w := &walker{
+		b:        b,
+		instance: instance,
+		key:      linkKey,
+		cursor:   args.Cursor,
+		depth:    args.Depth,
+		seeds:    true,
+	}
+	if err := w.walk(ctx, n, bpth.String(), pth, 0, func(rel string, item *PathItem, depth int, after bool) error {
+		if depth == 0 && (item.IsDir || args.Cursor != "") || depth > 0 && !after {
+			return nil
+		}
+		if args.Limit > 0 && count == args.Limit {
+			return errWalkDone
+		}
+		count++
+		return fn(rel, item)
+	}); err != nil && !errors.Is(err, errWalkDone) {
+		return err
+	}
+	log.Debugf("walked %s in %s", pth, key)
+	return nil
 }
 
 func (b *Buckets) ListIPFSPath(ctx context.Context, pth string) (*PathItem, error) {
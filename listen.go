@@ -0,0 +1,618 @@
+package buckets
+
+import (
+	"context"
+	"fmt"
+	gopath "path"
+	"sort"
+	"sync"
+	"time"
+
+	c "github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	mdag "github.com/ipfs/go-merkledag"
+	"github.com/ipfs/go-unixfs"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/dag"
+	dbc "github.com/textileio/go-threads/api/client"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+// EventType is the type of a bucket change event.
+type EventType int
+
+const (
+	// EventPathAdded indicates a file or directory was added at Path.
+	EventPathAdded EventType = iota
+	// EventPathModified indicates the file or directory at Path was replaced.
+	EventPathModified
+	// EventPathRemoved indicates Path was removed.
+	EventPathRemoved
+	// EventPathMoved indicates Path was moved to ToPath.
+	EventPathMoved
+	// EventRolesChanged indicates the access roles at Path changed.
+	EventRolesChanged
+	// EventAttributesChanged indicates the custom attributes at Path changed.
+	EventAttributesChanged
+	// EventRootReplaced indicates the bucket root was replaced in a way that can't be described by path-level changes,
+	// e.g., by rotating keys or changing privacy.
+	EventRootReplaced
+	// EventBucketRemoved indicates the bucket was removed. It's always the last event.
+	EventBucketRemoved
+)
+
+// String returns the string representation of the event type.
+func (t EventType) String() string {
+	switch t {
+	case EventPathAdded:
+		return "path_added"
+	case EventPathModified:
+		return "path_modified"
+	case EventPathRemoved:
+		return "path_removed"
+	case EventPathMoved:
+		return "path_moved"
+	case EventRolesChanged:
+		return "roles_changed"
+	case EventAttributesChanged:
+		return "attributes_changed"
+	case EventRootReplaced:
+		return "root_replaced"
+	case EventBucketRemoved:
+		return "bucket_removed"
+	default:
+		return "invalid"
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t EventType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// Event describes a single change to a bucket.
+type Event struct {
+	// Type is the type of change.
+	Type EventType `json:"type"`
+	// Thread is the thread ID of the bucket.
+	Thread core.ID `json:"thread"`
+	// Key is the bucket key.
+	Key string `json:"key"`
+	// Path is the changed path. It's empty for bucket-level events.
+	Path string `json:"path"`
+	// ToPath is the destination of a move.
+	ToPath string `json:"to_path"`
+	// Identity is the DID of the identity that made the change.
+	// It's empty if the change was replicated from a peer and didn't add a bucket version,
+	// e.g., a change to roles or attributes.
+	Identity did.DID `json:"identity"`
+	// OldRoot is the bucket root path before the change.
+	OldRoot string `json:"old_root"`
+	// NewRoot is the bucket root path after the change. It's empty if the bucket was removed.
+	NewRoot string `json:"new_root"`
+	// Time is the time of the change.
+	Time time.Time `json:"time"`
+	// Bucket is the bucket after the change, as seen by the listener.
+	// It's nil if the bucket was removed.
+	Bucket *Bucket `json:"bucket,omitempty"`
+}
+
+// Listen returns a channel of bucket change events.
+// Changes made by this library instance are reported as they were made by the mutating call.
+// Changes made by other library instances and replicated from peers are derived from the bucket's
+// ThreadDB instance updates by diffing the old and new root and metadata.
+// Changes to paths that identity can't read are not sent.
+// The returned channels are closed when ctx is canceled, the bucket is removed, or an error occurs.
+func (b *Buckets) Listen(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	identity did.Token,
+) (<-chan Event, <-chan error, error) {
+	// Ensure read access before listening
+	mctx, prev, err := b.getSafe(ctx, thread, key, identity)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Group members can't listen with their own identity since the collection can't resolve groups
+	listener := identity
+	if _, ok := memberFromContext(mctx, thread, prev); ok {
+		listener, err = b.serverToken(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	prev = view(mctx, thread, prev)
+	updates, err := b.c.Listen(ctx, thread, key, collection.WithIdentity(listener))
+	if err != nil {
+		return nil, nil, fmt.Errorf("listening to bucket: %v", err)
+	}
+
+	out := make(chan Event)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errs)
+		for u := range updates {
+			if u.Err != nil {
+				errs <- u.Err
+				return
+			}
+			var (
+				instance *collection.Bucket
+				events   []Event
+			)
+			switch u.Action.Type {
+			case dbc.ActionDelete:
+				events = []Event{{
+					Type:    EventBucketRemoved,
+					Thread:  thread,
+					Key:     key,
+					OldRoot: prev.Path,
+					Time:    time.Now(),
+				}}
+			case dbc.ActionCreate, dbc.ActionSave:
+				var err error
+				instance, err = b.getView(ctx, thread, key, identity)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if saved, ok := b.changes.get(thread, instance); ok && saved.oldRoot == prev.Path {
+					events = saved.events(thread, instance)
+				} else {
+					events = b.diffInstances(ctx, thread, prev, instance)
+				}
+				prev = instance
+			}
+			for _, e := range events {
+				e, ok := filterEvent(instance, e)
+				if !ok {
+					continue
+				}
+				select {
+				case out <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if u.Action.Type == dbc.ActionDelete {
+				return
+			}
+		}
+	}()
+
+	log.Debugf("listening to %s", key)
+	return out, errs, nil
+}
+
+// filterEvent prepares e for a listener that can see instance.
+// Moves are reported as additions or removals if only one side of the move is readable.
+// ok is false if no part of the change is readable.
+func filterEvent(instance *collection.Bucket, e Event) (Event, bool) {
+	if instance == nil {
+		return e, true
+	}
+	e.Bucket = instanceToBucket(e.Thread, instance)
+	canRead := func(pth string) bool {
+		_, _, ok := instance.GetMetadataForPath(pth, false)
+		return ok
+	}
+	switch e.Type {
+	case EventRootReplaced:
+		return e, true
+	case EventPathMoved:
+		from, to := canRead(e.Path), canRead(e.ToPath)
+		switch {
+		case from && to:
+		case from:
+			e.Type = EventPathRemoved
+			e.ToPath = ""
+		case to:
+			e.Type = EventPathAdded
+			e.Path, e.ToPath = e.ToPath, ""
+		default:
+			return e, false
+		}
+		return e, true
+	default:
+		return e, canRead(e.Path)
+	}
+}
+
+// changeTTL is how long changes saved by this library instance are kept for listeners.
+const changeTTL = time.Minute
+
+// change is a path-level change made by a mutating call.
+type change struct {
+	typ    EventType
+	path   string
+	toPath string
+}
+
+// pathChange returns the change made by setting pth in instance.
+// It must be called before pth is set.
+func (b *Buckets) pathChange(ctx context.Context, instance *collection.Bucket, pth string) change {
+	typ := EventPathAdded
+	if bpth, err := getBucketPath(instance, pth); err == nil {
+		if _, err := dag.GetNodeAtPath(ctx, b.ipfs, bpth, instance.GetLinkEncryptionKey()); err == nil {
+			typ = EventPathModified
+		}
+	}
+	return change{typ: typ, path: pth}
+}
+
+// savedChange is a bucket update saved by this library instance.
+type savedChange struct {
+	identity did.DID
+	oldRoot  string
+	changes  []change
+	expires  time.Time
+}
+
+// events returns the events that describe s, which resulted in instance.
+func (s savedChange) events(thread core.ID, instance *collection.Bucket) []Event {
+	events := make([]Event, len(s.changes))
+	for i, ch := range s.changes {
+		events[i] = Event{
+			Type:     ch.typ,
+			Thread:   thread,
+			Key:      instance.Key,
+			Path:     ch.path,
+			ToPath:   ch.toPath,
+			Identity: s.identity,
+			OldRoot:  s.oldRoot,
+			NewRoot:  instance.Path,
+			Time:     time.Unix(0, instance.UpdatedAt),
+		}
+	}
+	return events
+}
+
+// changeKey identifies a bucket update by the root and update time it was saved with.
+type changeKey struct {
+	thread    core.ID
+	key       string
+	root      string
+	updatedAt int64
+}
+
+func newChangeKey(thread core.ID, instance *collection.Bucket) changeKey {
+	return changeKey{
+		thread:    thread,
+		key:       instance.Key,
+		root:      instance.Path,
+		updatedAt: instance.UpdatedAt,
+	}
+}
+
+// changeLog holds the changes recently saved by this library instance,
+// so listeners can report them without diffing the bucket.
+type changeLog struct {
+	lk      sync.Mutex
+	changes map[changeKey]savedChange
+	pruned  time.Time
+}
+
+func newChangeLog() *changeLog {
+	return &changeLog{
+		changes: make(map[changeKey]savedChange),
+		pruned:  time.Now(),
+	}
+}
+
+// add logs the changes made to instance by identity.
+// It must be called before instance is saved so that listeners can't see the update first.
+func (l *changeLog) add(thread core.ID, instance *collection.Bucket, identity did.DID, oldRoot string, changes ...change) {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	now := time.Now()
+	if now.Sub(l.pruned) > changeTTL {
+		for k, s := range l.changes {
+			if now.After(s.expires) {
+				delete(l.changes, k)
+			}
+		}
+		l.pruned = now
+	}
+	l.changes[newChangeKey(thread, instance)] = savedChange{
+		identity: identity,
+		oldRoot:  oldRoot,
+		changes:  changes,
+		expires:  now.Add(changeTTL),
+	}
+}
+
+// get returns the logged changes that resulted in instance.
+func (l *changeLog) get(thread core.ID, instance *collection.Bucket) (savedChange, bool) {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	s, ok := l.changes[newChangeKey(thread, instance)]
+	return s, ok
+}
+
+// diffInstances returns the events that describe the update from old to instance.
+// Path-level events are found by diffing the bucket roots, and role and attribute changes by diffing metadata.
+// Subtrees that were only re-encrypted with new file keys, e.g., by changing access roles, aren't diffed.
+// The root is reported as replaced if the link key changed, e.g., by rotating keys or changing privacy,
+// or if the roots can't be diffed.
+func (b *Buckets) diffInstances(ctx context.Context, thread core.ID, old, instance *collection.Bucket) []Event {
+	base := Event{
+		Thread:  thread,
+		Key:     instance.Key,
+		OldRoot: old.Path,
+		NewRoot: instance.Path,
+		Time:    time.Unix(0, instance.UpdatedAt),
+	}
+	var events []Event
+	changed := make(map[string]struct{})
+	if old.Path != instance.Path {
+		if n := len(instance.History); n > 0 && instance.History[n-1].Path == instance.Path {
+			base.Identity = instance.History[n-1].Author
+		}
+		var diffs []pathDiff
+		replaced := old.LinkKey != instance.LinkKey
+		if !replaced {
+			var err error
+			diffs, err = b.diffRoots(ctx, old, instance, rotatedPaths(old, instance))
+			if err != nil {
+				log.Warnf("diffing roots of %s: %v", instance.Key, err)
+				replaced = true
+			}
+		}
+		if replaced {
+			e := base
+			e.Type = EventRootReplaced
+			events = append(events, e)
+		}
+		for _, d := range diffs {
+			e := base
+			e.Type = d.typ
+			e.Path = d.path
+			e.ToPath = d.toPath
+			events = append(events, e)
+			changed[d.path] = struct{}{}
+			if d.toPath != "" {
+				changed[d.toPath] = struct{}{}
+			}
+		}
+	}
+
+	paths := make(map[string]struct{})
+	for pth := range old.Metadata {
+		paths[pth] = struct{}{}
+	}
+	for pth := range instance.Metadata {
+		paths[pth] = struct{}{}
+	}
+	// Metadata for paths with path-level changes is expected to change with them
+	sorted := make([]string, 0, len(paths))
+	for pth := range paths {
+		if !hasChangedParent(changed, pth) {
+			sorted = append(sorted, pth)
+		}
+	}
+	sort.Strings(sorted)
+	for _, pth := range sorted {
+		omd, nmd := old.Metadata[pth], instance.Metadata[pth]
+		if !rolesEqual(omd.Roles, nmd.Roles) {
+			e := base
+			e.Type = EventRolesChanged
+			e.Path = pth
+			events = append(events, e)
+		}
+		if !attributesEqual(omd.Attributes, nmd.Attributes) {
+			e := base
+			e.Type = EventAttributesChanged
+			e.Path = pth
+			events = append(events, e)
+		}
+	}
+
+	return events
+}
+
+// pathDiff is a path-level difference between two bucket roots.
+type pathDiff struct {
+	typ    EventType
+	path   string
+	toPath string
+	cid    c.Cid
+}
+
+// diffRoots returns the path-level differences between the roots of old and instance.
+// Subtrees with the same cid are skipped. Directories are compared by their links,
+// and added or removed directories are reported as a whole.
+// A removal and an addition of the same cid are reported as a move.
+// Moves in private buckets re-encrypt the moved path, so they are seen as a removal and an addition.
+// Subtrees at rotated paths are skipped.
+func (b *Buckets) diffRoots(
+	ctx context.Context,
+	old, instance *collection.Bucket,
+	rotated map[string]struct{},
+) ([]pathDiff, error) {
+	if _, ok := rotated[""]; ok {
+		return nil, nil
+	}
+	key := instance.GetLinkEncryptionKey()
+	on, err := dag.GetNodeAtPath(ctx, b.ipfs, path.New(old.Path), key)
+	if err != nil {
+		return nil, fmt.Errorf("getting old root: %v", err)
+	}
+	nn, err := dag.GetNodeAtPath(ctx, b.ipfs, path.New(instance.Path), key)
+	if err != nil {
+		return nil, fmt.Errorf("getting new root: %v", err)
+	}
+	var diffs []pathDiff
+	if err := b.diffDirs(ctx, on, nn, "", key, rotated, &diffs); err != nil {
+		return nil, err
+	}
+
+	// Pair removals and additions of the same node
+	added := make(map[c.Cid]int)
+	for i, d := range diffs {
+		if d.typ == EventPathAdded {
+			added[d.cid] = i
+		}
+	}
+	moved := make(map[int]struct{})
+	for i, d := range diffs {
+		if d.typ != EventPathRemoved {
+			continue
+		}
+		if j, ok := added[d.cid]; ok {
+			diffs[i].typ = EventPathMoved
+			diffs[i].toPath = diffs[j].path
+			moved[j] = struct{}{}
+			delete(added, d.cid)
+		}
+	}
+	res := diffs[:0]
+	for i, d := range diffs {
+		if _, ok := moved[i]; !ok {
+			res = append(res, d)
+		}
+	}
+	return res, nil
+}
+
+// diffDirs appends the differences between the decrypted directory nodes on and nn to diffs.
+func (b *Buckets) diffDirs(
+	ctx context.Context,
+	on, nn ipld.Node,
+	rel string,
+	key []byte,
+	rotated map[string]struct{},
+	diffs *[]pathDiff,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	links := func(n ipld.Node) map[string]*ipld.Link {
+		m := make(map[string]*ipld.Link)
+		for _, l := range n.Links() {
+			if l.Name != "" && l.Name != collection.SeedName {
+				m[l.Name] = l
+			}
+		}
+		return m
+	}
+	ol, nl := links(on), links(nn)
+	names := make([]string, 0, len(ol)+len(nl))
+	for name := range ol {
+		names = append(names, name)
+	}
+	for name := range nl {
+		if _, ok := ol[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pth := gopath.Join(rel, name)
+		o, n := ol[name], nl[name]
+		switch {
+		case o == nil:
+			*diffs = append(*diffs, pathDiff{typ: EventPathAdded, path: pth, cid: n.Cid})
+		case n == nil:
+			*diffs = append(*diffs, pathDiff{typ: EventPathRemoved, path: pth, cid: o.Cid})
+		case o.Cid.Equals(n.Cid):
+		case isRotated(rotated, pth):
+		default:
+			ocn, odir, err := b.getLinkNode(ctx, o, key)
+			if err != nil {
+				return err
+			}
+			ncn, ndir, err := b.getLinkNode(ctx, n, key)
+			if err != nil {
+				return err
+			}
+			if odir && ndir {
+				if err := b.diffDirs(ctx, ocn, ncn, pth, key, rotated, diffs); err != nil {
+					return err
+				}
+				continue
+			}
+			*diffs = append(*diffs, pathDiff{typ: EventPathModified, path: pth, cid: n.Cid})
+		}
+	}
+	return nil
+}
+
+// getLinkNode returns the decrypted node at l and whether or not it's a directory.
+func (b *Buckets) getLinkNode(ctx context.Context, l *ipld.Link, key []byte) (ipld.Node, bool, error) {
+	n, err := l.GetNode(ctx, b.ipfs.Dag())
+	if err != nil {
+		return nil, false, err
+	}
+	if key != nil {
+		return dag.DecryptNode(n, key)
+	}
+	pn, ok := n.(*mdag.ProtoNode)
+	if !ok {
+		return n, false, nil
+	}
+	fn, err := unixfs.FSNodeFromBytes(pn.Data())
+	if err != nil {
+		return nil, false, err
+	}
+	return n, fn.IsDir(), nil
+}
+
+// rotatedPaths returns the paths whose file keys differ between old and instance.
+// The subtrees at these paths were re-encrypted with new file keys.
+func rotatedPaths(old, instance *collection.Bucket) map[string]struct{} {
+	rotated := make(map[string]struct{})
+	if !instance.IsPrivate() {
+		return rotated
+	}
+	for pth, md := range instance.Metadata {
+		if omd, ok := old.Metadata[pth]; ok && omd.Key != "" && omd.Key != md.Key {
+			rotated[pth] = struct{}{}
+		}
+	}
+	return rotated
+}
+
+// isRotated returns whether or not pth is in rotated.
+func isRotated(rotated map[string]struct{}, pth string) bool {
+	_, ok := rotated[pth]
+	return ok
+}
+
+// hasChangedParent returns whether or not pth or one of its parents is in changed.
+func hasChangedParent(changed map[string]struct{}, pth string) bool {
+	for pth != "" && pth != "." {
+		if _, ok := changed[pth]; ok {
+			return true
+		}
+		pth = gopath.Dir(pth)
+	}
+	return false
+}
+
+func rolesEqual(a, b map[did.DID]collection.Role) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
+
+func attributesEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
@@ -3,6 +3,8 @@ package local
 import (
 	"context"
 
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets"
 	"github.com/textileio/go-buckets/collection"
 	"github.com/textileio/go-threads/core/did"
 )
@@ -12,11 +14,14 @@ import (
 // roles is a map of string marshaled public keys to path roles. A non-nil error is returned
 // if the map keys are not unmarshalable to public keys.
 // To delete a role for a public key, set its value to buckets.None.
+// The push is rejected if the remote bucket has changes that have not been pulled.
 func (b *Bucket) PushPathAccessRoles(
 	ctx context.Context,
 	pth string,
 	roles map[did.DID]collection.Role,
 ) (merged map[did.DID]collection.Role, err error) {
+	b.Lock()
+	defer b.Unlock()
 	ctx, err = b.authCtx(ctx)
 	if err != nil {
 		return
@@ -25,9 +30,23 @@ func (b *Bucket) PushPathAccessRoles(
 	if err != nil {
 		return
 	}
-	if err = b.c.PushPathAccessRoles(ctx, id, b.Key(), pth, roles); err != nil {
+	r, err := b.Roots(ctx)
+	if err != nil {
+		return
+	}
+	xr := path.IpfsPath(r.Remote)
+	if err = b.c.PushPathAccessRoles(ctx, id, b.Key(), pth, roles, buckets.WithFastForwardOnly(xr)); err != nil {
 		return
 	}
+	if b.repo != nil {
+		rc, err := b.getRemoteRoot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := b.repo.SetRemotePath("", rc); err != nil {
+			return nil, err
+		}
+	}
 	return b.c.PullPathAccessRoles(ctx, id, b.Key(), pth)
 }
 
@@ -43,3 +62,17 @@ func (b *Bucket) PullPathAccessRoles(ctx context.Context, pth string) (roles map
 	}
 	return b.c.PullPathAccessRoles(ctx, id, b.Key(), pth)
 }
+
+// EffectiveAccess explains the access role of an identity at a path.
+// If id is empty, the bucket identity's access is explained.
+func (b *Bucket) EffectiveAccess(ctx context.Context, pth string, id did.DID) (report *buckets.AccessReport, err error) {
+	ctx, err = b.authCtx(ctx)
+	if err != nil {
+		return
+	}
+	tid, err := b.Thread()
+	if err != nil {
+		return
+	}
+	return b.c.EffectiveAccess(ctx, tid, b.Key(), pth, id)
+}
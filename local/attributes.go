@@ -0,0 +1,51 @@
+package local
+
+import (
+	"context"
+
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets"
+)
+
+// PushPathAttributes updates custom path attributes by merging the pushed attributes with existing attributes
+// and returns the merged attributes.
+// To delete an attribute, set its value to an empty string.
+// The push is rejected if the remote bucket has changes that have not been pulled.
+func (b *Bucket) PushPathAttributes(
+	ctx context.Context,
+	pth string,
+	attrs map[string]string,
+) (merged map[string]string, err error) {
+	b.Lock()
+	defer b.Unlock()
+	ctx, err = b.authCtx(ctx)
+	if err != nil {
+		return
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return
+	}
+	r, err := b.Roots(ctx)
+	if err != nil {
+		return
+	}
+	xr := path.IpfsPath(r.Remote)
+	if err = b.c.PushPathAttributes(ctx, id, b.Key(), pth, attrs, buckets.WithFastForwardOnly(xr)); err != nil {
+		return
+	}
+	return b.c.PullPathAttributes(ctx, id, b.Key(), pth)
+}
+
+// PullPathAttributes returns custom attributes for a path.
+func (b *Bucket) PullPathAttributes(ctx context.Context, pth string) (attrs map[string]string, err error) {
+	ctx, err = b.authCtx(ctx)
+	if err != nil {
+		return
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return
+	}
+	return b.c.PullPathAttributes(ctx, id, b.Key(), pth)
+}
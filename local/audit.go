@@ -0,0 +1,22 @@
+package local
+
+import (
+	"context"
+
+	"github.com/textileio/go-buckets/audit"
+)
+
+// AuditRecords returns audit records for the remote bucket matching query, newest first.
+// Only records made by the bucket identity are returned unless it's the bucket owner or an admin.
+func (b *Bucket) AuditRecords(ctx context.Context, query audit.Query) ([]*audit.Record, error) {
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return nil, err
+	}
+	query.Key = b.Key()
+	return b.c.ListAuditRecords(ctx, id, query)
+}
@@ -117,19 +117,31 @@ func (b *Bucket) LocalSize() (int64, error) {
 	if err != nil {
 		return 0, err
 	}
+	ig, err := newIgnorer(bp)
+	if err != nil {
+		return 0, err
+	}
 	var size int64
 	err = filepath.Walk(bp, func(n string, info os.FileInfo, err error) error {
 		if err != nil {
 			return fmt.Errorf("getting fileinfo of %s: %s", n, err)
 		}
-		if !info.IsDir() {
-			f := strings.TrimPrefix(n, bp+string(os.PathSeparator))
-			if Ignore(n) || (strings.HasPrefix(f, b.conf.Dir) && f != collection.SeedName) {
-				return nil
+		ignored, err := ig.Ignored(n, info.IsDir())
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if ignored {
+				return filepath.SkipDir
 			}
-			size += info.Size()
+			return nil
 		}
-		return err
+		f := strings.TrimPrefix(n, bp+string(os.PathSeparator))
+		if ignored || (strings.HasPrefix(f, b.conf.Dir) && f != collection.SeedName) {
+			return nil
+		}
+		size += info.Size()
+		return nil
 	})
 	return size, err
 }
@@ -233,6 +245,24 @@ func (b *Bucket) CatRemotePath(ctx context.Context, pth string, w io.Writer) err
 	return b.c.PullPath(ctx, id, b.Key(), pth, w)
 }
 
+// CatRemotePathArchive writes the remote path and all of its descendants to writer as an archive.
+func (b *Bucket) CatRemotePathArchive(
+	ctx context.Context,
+	pth string,
+	w io.Writer,
+	format buckets.ArchiveFormat,
+) error {
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return err
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return err
+	}
+	return b.c.PullPathArchive(ctx, id, b.Key(), pth, w, format)
+}
+
 // Destroy completely deletes the local and remote bucket.
 func (b *Bucket) Destroy(ctx context.Context) (err error) {
 	b.Lock()
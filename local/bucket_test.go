@@ -6,6 +6,7 @@ import (
 	"crypto/rand"
 	"errors"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
@@ -251,6 +252,80 @@ func TestBucket_PullRemote(t *testing.T) {
 	ec.check(t, 0, 1)
 }
 
+func TestBucket_Merge(t *testing.T) {
+	buckets := setup(t)
+	buck, err := buckets.NewBucket(context.Background(), getConf(t))
+	require.NoError(t, err)
+	addRandomFile(t, buck, "shared", 1024)
+	addRandomFile(t, buck, "conflict", 1024)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	conf2 := Config{Path: newDir(t)}
+	conf2.Key = buck.Key()
+	conf2.Thread, err = buck.Thread()
+	require.NoError(t, err)
+	conf2.Identity, err = buck.Identity()
+	require.NoError(t, err)
+	buck2, err := buckets.NewBucket(context.Background(), conf2)
+	require.NoError(t, err)
+
+	// Change the remote via the first bucket
+	addRandomFile(t, buck, "remote", 1024)
+	addRandomFile(t, buck, "conflict", 1024)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	// Change the same remote via the second bucket
+	lpth := addRandomFile(t, buck2, "local", 1024)
+	cpth := addRandomFile(t, buck2, "conflict", 1024)
+
+	// A normal push is rejected
+	_, err = buck2.PushLocal(context.Background())
+	require.Error(t, err)
+
+	// Conflicts are reported without a resolver
+	_, err = buck2.PushLocal(context.Background(), WithMerge(true))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrMergeConflict))
+	var cerr *ConflictError
+	require.True(t, errors.As(err, &cerr))
+	require.Len(t, cerr.Conflicts, 1)
+	assert.Equal(t, "conflict", cerr.Conflicts[0].Path)
+	assert.Equal(t, du.Mod, cerr.Conflicts[0].Local)
+	assert.Equal(t, du.Mod, cerr.Conflicts[0].Remote)
+
+	// Conflicts are resolved with a resolver, non-conflicting changes are merged
+	_, err = buck2.PushLocal(
+		context.Background(),
+		WithMerge(true),
+		WithResolveConflict(func(c Conflict) (ConflictStrategy, error) {
+			return KeepLocal, nil
+		}),
+	)
+	require.NoError(t, err)
+	bp2, err := buck2.Path()
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(bp2, "remote"))
+	require.NoError(t, err)
+
+	// The first bucket can now pull all changes
+	_, err = buck.PullRemote(context.Background(), WithMerge(true))
+	require.NoError(t, err)
+	bp, err := buck.Path()
+	require.NoError(t, err)
+	for _, p := range []string{lpth, cpth} {
+		want, err := ioutil.ReadFile(p)
+		require.NoError(t, err)
+		got, err := ioutil.ReadFile(filepath.Join(bp, filepath.Base(p)))
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(want, got))
+	}
+	diff, err := buck.DiffLocal()
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
 func TestBucket_AddRemoteCid(t *testing.T) {
 	buckets := setup(t)
 	conf := getConf(t)
@@ -99,20 +99,36 @@ func (b *Bucket) DiffLocal() ([]Change, error) {
 }
 
 func (b *Bucket) walkPath(pth string) (names []string, err error) {
+	bp, err := b.Path()
+	if err != nil {
+		return
+	}
+	ig, err := newIgnorer(bp)
+	if err != nil {
+		return
+	}
 	err = filepath.Walk(pth, func(n string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
-		if !info.IsDir() {
-			f := strings.TrimPrefix(n, pth+string(os.PathSeparator))
-			if Ignore(n) ||
-				f == collection.SeedName ||
-				strings.HasPrefix(f, b.conf.Dir) ||
-				strings.HasSuffix(f, patchExt) {
-				return nil
+		ignored, err := ig.Ignored(n, info.IsDir())
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if ignored {
+				return filepath.SkipDir
 			}
-			names = append(names, n)
+			return nil
 		}
+		f := strings.TrimPrefix(n, pth+string(os.PathSeparator))
+		if ignored ||
+			f == collection.SeedName ||
+			strings.HasPrefix(f, b.conf.Dir) ||
+			strings.HasSuffix(f, patchExt) {
+			return nil
+		}
+		names = append(names, n)
 		return nil
 	})
 	if err != nil {
@@ -120,3 +136,47 @@ func (b *Bucket) walkPath(pth string) (names []string, err error) {
 	}
 	return names, nil
 }
+
+// IgnoredLocal returns a list of local files and directories that are skipped
+// because of the default ignored file names or .buckignore files.
+// Files inside an ignored directory are not listed.
+func (b *Bucket) IgnoredLocal() ([]Change, error) {
+	bp, err := b.Path()
+	if err != nil {
+		return nil, err
+	}
+	ig, err := newIgnorer(bp)
+	if err != nil {
+		return nil, err
+	}
+	var ignored []Change
+	err = filepath.Walk(bp, func(n string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		ok, err := ig.Ignored(n, info.IsDir())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		r, err := filepath.Rel(b.cwd, n)
+		if err != nil {
+			return err
+		}
+		p := strings.TrimPrefix(n, bp+string(os.PathSeparator))
+		if info.IsDir() {
+			r += string(os.PathSeparator)
+		}
+		ignored = append(ignored, Change{Name: n, Path: p, Rel: r})
+		if info.IsDir() {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ignored, nil
+}
@@ -0,0 +1,49 @@
+package local
+
+import (
+	"context"
+	"io"
+
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/api/cast"
+	"github.com/textileio/go-threads/core/thread"
+)
+
+// Export writes the current remote bucket dag to w as a CARv1 archive.
+// Archives of private buckets contain their encryption keys.
+func (b *Bucket) Export(ctx context.Context, w io.Writer) (err error) {
+	ctx, err = b.authCtx(ctx)
+	if err != nil {
+		return
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return
+	}
+	return b.c.Export(ctx, id, b.Key(), w)
+}
+
+// Import creates a new remote bucket in thread from an archive written by Export.
+// A new thread is created if thread is not defined.
+// Use NewBucket with the returned key and thread to pull the new bucket.
+func (b *Buckets) Import(
+	ctx context.Context,
+	id thread.ID,
+	identity thread.Identity,
+	r io.Reader,
+	opts ...buckets.ImportOption,
+) (bucket buckets.Bucket, links buckets.Links, err error) {
+	ctx, err = authCtx(ctx, b.c, identity)
+	if err != nil {
+		return
+	}
+	res, err := b.c.Import(ctx, id, r, opts...)
+	if err != nil {
+		return
+	}
+	bucket, err = cast.BucketFromPb(res.Bucket)
+	if err != nil {
+		return
+	}
+	return bucket, cast.LinksFromPb(res.Links), nil
+}
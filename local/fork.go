@@ -0,0 +1,37 @@
+package local
+
+import (
+	"context"
+
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/api/cast"
+	"github.com/textileio/go-threads/core/thread"
+)
+
+// Fork creates a new remote bucket from the current remote root.
+// The new bucket is created in toThread, which can be the bucket's thread.
+// A new thread is created if toThread is not defined.
+// Use NewBucket with the returned key and thread to pull the new bucket.
+func (b *Bucket) Fork(
+	ctx context.Context,
+	toThread thread.ID,
+	opts ...buckets.ForkOption,
+) (bucket buckets.Bucket, links buckets.Links, err error) {
+	ctx, err = b.authCtx(ctx)
+	if err != nil {
+		return
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return
+	}
+	res, err := b.c.Fork(ctx, id, b.Key(), toThread, opts...)
+	if err != nil {
+		return
+	}
+	bucket, err = cast.BucketFromPb(res.Bucket)
+	if err != nil {
+		return
+	}
+	return bucket, cast.LinksFromPb(res.Links), nil
+}
@@ -0,0 +1,73 @@
+package local
+
+import (
+	"context"
+
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-threads/core/did"
+)
+
+// CreateGroup creates a named group of identities in the bucket's thread.
+func (b *Bucket) CreateGroup(ctx context.Context, name string, members ...did.DID) (*buckets.Group, error) {
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return nil, err
+	}
+	return b.c.CreateGroup(ctx, id, name, members...)
+}
+
+// ListGroups returns all groups in the bucket's thread.
+func (b *Bucket) ListGroups(ctx context.Context) ([]*buckets.Group, error) {
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return nil, err
+	}
+	return b.c.ListGroups(ctx, id)
+}
+
+// AddGroupMembers adds members to a group in the bucket's thread.
+func (b *Bucket) AddGroupMembers(ctx context.Context, name string, members ...did.DID) (*buckets.Group, error) {
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return nil, err
+	}
+	return b.c.AddGroupMembers(ctx, id, name, members...)
+}
+
+// RemoveGroupMembers removes members from a group in the bucket's thread.
+func (b *Bucket) RemoveGroupMembers(ctx context.Context, name string, members ...did.DID) (*buckets.Group, error) {
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return nil, err
+	}
+	return b.c.RemoveGroupMembers(ctx, id, name, members...)
+}
+
+// DeleteGroup deletes a group in the bucket's thread.
+func (b *Bucket) DeleteGroup(ctx context.Context, name string) error {
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return err
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return err
+	}
+	return b.c.DeleteGroup(ctx, id, name)
+}
@@ -0,0 +1,210 @@
+package local
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IgnoreFileName is the name of the file containing gitignore-style patterns.
+// Ignore files can be placed in the bucket root or any nested directory.
+// Patterns are relative to the directory containing the ignore file.
+const IgnoreFileName = ".buckignore"
+
+// ignorePattern is a single pattern from an ignore file.
+type ignorePattern struct {
+	parts    []string // Slash-separated pattern segments
+	negate   bool     // Pattern re-includes matching paths
+	dirOnly  bool     // Pattern only matches directories
+	anchored bool     // Pattern is relative to the ignore file directory
+}
+
+// parseIgnorePattern parses a line from an ignore file.
+// Blank lines and comments return false.
+func parseIgnorePattern(line string) (p ignorePattern, ok bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return p, false
+	}
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\`) {
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if strings.Contains(line, "/") {
+		p.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+	if line == "" {
+		return p, false
+	}
+	p.parts = strings.Split(line, "/")
+	return p, true
+}
+
+// match returns whether or not the slash-separated path rel,
+// relative to the ignore file directory, matches the pattern.
+func (p ignorePattern) match(rel string, isDir bool) bool {
+	if p.dirOnly && !isDir {
+		return false
+	}
+	segs := strings.Split(rel, "/")
+	if !p.anchored {
+		ok, _ := path.Match(p.parts[0], segs[len(segs)-1])
+		return ok
+	}
+	return matchParts(p.parts, segs)
+}
+
+// matchParts matches path segments against pattern segments, where "**" matches zero or more segments.
+func matchParts(parts, segs []string) bool {
+	for len(parts) > 0 {
+		if parts[0] == "**" {
+			parts = parts[1:]
+			if len(parts) == 0 {
+				return true
+			}
+			for i := 0; i <= len(segs); i++ {
+				if matchParts(parts, segs[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segs) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(parts[0], segs[0]); !ok {
+			return false
+		}
+		parts = parts[1:]
+		segs = segs[1:]
+	}
+	return len(segs) == 0
+}
+
+// ignorer matches local paths against the default ignored file names
+// and the patterns in all ignore files under a bucket root.
+type ignorer struct {
+	root     string
+	patterns map[string][]ignorePattern // Keyed by slash-separated directory relative to root
+	dirs     map[string]bool            // Cached directory results
+}
+
+// newIgnorer returns an ignorer for the bucket root.
+func newIgnorer(root string) (*ignorer, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	return &ignorer{
+		root:     root,
+		patterns: make(map[string][]ignorePattern),
+		dirs:     make(map[string]bool),
+	}, nil
+}
+
+// Ignored returns whether or not the named file or directory should be ignored.
+// A path is ignored if any of its parent directories are ignored.
+func (i *ignorer) Ignored(name string, isDir bool) (bool, error) {
+	if Ignore(name) {
+		return true, nil
+	}
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return false, err
+	}
+	rel, err := filepath.Rel(i.root, abs)
+	if err != nil {
+		return false, err
+	}
+	if rel == "." || strings.HasPrefix(rel, ".."+string(os.PathSeparator)) {
+		return false, nil
+	}
+	return i.ignored(filepath.ToSlash(rel), isDir)
+}
+
+func (i *ignorer) ignored(rel string, isDir bool) (bool, error) {
+	if isDir {
+		if ignored, ok := i.dirs[rel]; ok {
+			return ignored, nil
+		}
+	}
+	dir := path.Dir(rel)
+	if dir != "." {
+		parent, err := i.ignored(dir, true)
+		if err != nil {
+			return false, err
+		}
+		if parent {
+			return i.cache(rel, isDir, true), nil
+		}
+	}
+
+	// Check patterns from the root down, the last match wins
+	var ignored bool
+	dirs := []string{""}
+	if dir != "." {
+		segs := strings.Split(dir, "/")
+		for j := range segs {
+			dirs = append(dirs, strings.Join(segs[:j+1], "/"))
+		}
+	}
+	for _, d := range dirs {
+		patterns, err := i.load(d)
+		if err != nil {
+			return false, err
+		}
+		r := rel
+		if d != "" {
+			r = strings.TrimPrefix(rel, d+"/")
+		}
+		for _, p := range patterns {
+			if p.match(r, isDir) {
+				ignored = !p.negate
+			}
+		}
+	}
+	return i.cache(rel, isDir, ignored), nil
+}
+
+func (i *ignorer) cache(rel string, isDir, ignored bool) bool {
+	if isDir {
+		i.dirs[rel] = ignored
+	}
+	return ignored
+}
+
+// load returns the patterns from the ignore file in dir, if it exists.
+func (i *ignorer) load(dir string) ([]ignorePattern, error) {
+	if patterns, ok := i.patterns[dir]; ok {
+		return patterns, nil
+	}
+	f, err := os.Open(filepath.Join(i.root, filepath.FromSlash(dir), IgnoreFileName))
+	if os.IsNotExist(err) {
+		i.patterns[dir] = nil
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var patterns []ignorePattern
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if p, ok := parseIgnorePattern(s.Text()); ok {
+			patterns = append(patterns, p)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	i.patterns[dir] = patterns
+	return patterns, nil
+}
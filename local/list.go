@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 
 	"github.com/ipfs/go-cid"
+	"github.com/textileio/go-buckets"
 	pb "github.com/textileio/go-buckets/api/pb/buckets"
 )
 
@@ -61,6 +62,69 @@ func (b *Bucket) ListRemotePath(ctx context.Context, pth string) (items []Bucket
 	return items, nil
 }
 
+// WalkRemotePath calls fn for each remote bucket item below path in depth-first name order.
+// If path is a file, fn is called once for the file.
+// rel is the item path relative to the bucket root.
+func (b *Bucket) WalkRemotePath(ctx context.Context, pth string, fn func(rel string, item BucketItem) error) error {
+	pth = filepath.ToSlash(pth)
+	if pth == "." || pth == "/" || pth == "./" {
+		pth = ""
+	}
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return err
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return err
+	}
+	it, err := b.c.ListPathStream(ctx, id, b.Key(), pth, buckets.WithListDepth(buckets.ListDepthUnlimited))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for it.Next() {
+		item, err := pbItemToItem(it.Item())
+		if err != nil {
+			return err
+		}
+		if err := fn(it.Cursor(), item); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// Search returns a page of remote bucket items that match query.
+// Pass the returned cursor in the next query to fetch the next page.
+// An empty cursor indicates there are no more results.
+func (b *Bucket) Search(ctx context.Context, query buckets.SearchQuery) (items []BucketItem, cursor string, err error) {
+	query.Path = filepath.ToSlash(query.Path)
+	if query.Path == "." || query.Path == "/" || query.Path == "./" {
+		query.Path = ""
+	}
+	ctx, err = b.authCtx(ctx)
+	if err != nil {
+		return
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return
+	}
+	rep, err := b.c.Search(ctx, id, b.Key(), query)
+	if err != nil {
+		return
+	}
+	items = make([]BucketItem, len(rep.Items))
+	for i, pi := range rep.Items {
+		items[i], err = pbItemToItem(pi)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	return items, rep.Cursor, nil
+}
+
 func pbItemToItem(pi *pb.PathItem) (item BucketItem, err error) {
 	if pi.Cid == "" {
 		return item, errEmptyItem
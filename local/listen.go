@@ -0,0 +1,22 @@
+package local
+
+import (
+	"context"
+
+	"github.com/textileio/go-buckets/api/client"
+)
+
+// Listen returns a channel of typed change events for the remote bucket.
+// The channel is closed when ctx is canceled, the remote bucket is removed, or an error occurs,
+// in which case the last event contains the error.
+func (b *Bucket) Listen(ctx context.Context) (<-chan client.ListenEvent, error) {
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return nil, err
+	}
+	return b.c.Listen(ctx, id, b.Key())
+}
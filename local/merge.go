@@ -0,0 +1,237 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	du "github.com/ipfs/go-merkledag/dagutils"
+	"github.com/textileio/go-buckets/collection"
+)
+
+// ErrMergeConflict indicates that a path was changed both locally and remotely.
+var ErrMergeConflict = errors.New("merge conflict")
+
+// Conflict describes a path that was changed both locally and remotely
+// since the last push or pull.
+type Conflict struct {
+	Path   string        // File name relative to the bucket root
+	Rel    string        // File name relative to the bucket current working directory
+	Local  du.ChangeType // Local change type
+	Remote du.ChangeType // Remote change type
+}
+
+// ConflictError is returned when a merge results in conflicts
+// and no ResolveConflictFunc was provided.
+type ConflictError struct {
+	Conflicts []Conflict
+}
+
+// Error implements error.
+func (e *ConflictError) Error() string {
+	paths := make([]string, len(e.Conflicts))
+	for i, c := range e.Conflicts {
+		paths[i] = c.Rel
+	}
+	return fmt.Sprintf("%v: %s", ErrMergeConflict, strings.Join(paths, ", "))
+}
+
+// Unwrap returns ErrMergeConflict.
+func (e *ConflictError) Unwrap() error {
+	return ErrMergeConflict
+}
+
+type remoteChange struct {
+	Change
+	cid cid.Cid
+}
+
+// DiffRemote returns a list of remote bucket file changes since the last push or pull.
+// The last-synced remote root kept in the local repo is used as the common ancestor.
+func (b *Bucket) DiffRemote(ctx context.Context) ([]Change, error) {
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rdiff, err := b.diffRemote(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diff := make([]Change, len(rdiff))
+	for i, c := range rdiff {
+		diff[i] = c.Change
+	}
+	return diff, nil
+}
+
+func (b *Bucket) diffRemote(ctx context.Context) ([]remoteChange, error) {
+	if b.repo == nil {
+		return nil, ErrNotABucket
+	}
+	_, xr, err := b.repo.Root()
+	if err != nil {
+		return nil, err
+	}
+	if !xr.Defined() { // There's no common ancestor
+		return nil, nil
+	}
+	rr, err := b.getRemoteRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rr.Equals(xr) {
+		return nil, nil
+	}
+	bp, err := b.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	all, _, err := b.listPath(ctx, "", bp, true)
+	if err != nil {
+		return nil, err
+	}
+	var diff []remoteChange
+	seen := make(map[string]struct{})
+	for _, o := range all {
+		if o.path == collection.SeedName {
+			continue
+		}
+		seen[o.path] = struct{}{}
+		_, rc, err := b.repo.GetPathMap(o.path)
+		if err != nil && !errors.Is(err, ds.ErrNotFound) {
+			return nil, err
+		}
+		var ct du.ChangeType
+		if !rc.Defined() {
+			ct = du.Add
+		} else if !rc.Equals(o.cid) {
+			ct = du.Mod
+		} else {
+			continue
+		}
+		c, err := b.newChange(ct, bp, o.path)
+		if err != nil {
+			return nil, err
+		}
+		diff = append(diff, remoteChange{Change: c, cid: o.cid})
+	}
+
+	paths, err := b.repo.ListPaths(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range paths {
+		p = filepath.FromSlash(p)
+		if _, ok := seen[p]; ok || p == collection.SeedName {
+			continue
+		}
+		c, err := b.newChange(du.Remove, bp, p)
+		if err != nil {
+			return nil, err
+		}
+		diff = append(diff, remoteChange{Change: c})
+	}
+	return diff, nil
+}
+
+func (b *Bucket) newChange(t du.ChangeType, bp, pth string) (Change, error) {
+	n := filepath.Join(bp, pth)
+	r, err := filepath.Rel(b.cwd, n)
+	if err != nil {
+		return Change{}, err
+	}
+	return Change{Type: t, Name: n, Path: pth, Rel: r}, nil
+}
+
+// mergeDiff returns the local changes that should be kept after merging with remote changes.
+// Local changes that don't overlap with remote changes are always kept.
+// Overlapping changes with the same outcome are dropped, since they already exist on the remote.
+// All other overlapping changes are conflicts, which are resolved with resolve.
+// If resolve is nil, a ConflictError is returned.
+func (b *Bucket) mergeDiff(ctx context.Context, diff []Change, resolve ResolveConflictFunc) ([]Change, error) {
+	rdiff, err := b.diffRemote(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rdiff) == 0 {
+		return diff, nil
+	}
+
+	var kept []Change
+	var conflicts []Conflict
+	for _, l := range diff {
+		var conflict *remoteChange
+		var applied bool
+		for _, r := range rdiff {
+			if !overlaps(l.Path, r.Path) {
+				continue
+			}
+			same, err := b.isSameChange(l, r)
+			if err != nil {
+				return nil, err
+			}
+			if !same {
+				r := r
+				conflict = &r
+				break
+			}
+			if l.Path == r.Path || (r.Type == du.Remove && strings.HasPrefix(l.Path, r.Path+string(os.PathSeparator))) {
+				applied = true
+			}
+		}
+		if conflict == nil {
+			if !applied {
+				kept = append(kept, l)
+			}
+			continue
+		}
+		c := Conflict{Path: l.Path, Rel: l.Rel, Local: l.Type, Remote: conflict.Type}
+		if resolve == nil {
+			conflicts = append(conflicts, c)
+			continue
+		}
+		s, err := resolve(c)
+		if err != nil {
+			return nil, err
+		}
+		switch s {
+		case KeepLocal:
+			kept = append(kept, l)
+		case KeepRemote:
+		default:
+			return nil, fmt.Errorf("invalid conflict strategy: %s", s)
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, &ConflictError{Conflicts: conflicts}
+	}
+	return kept, nil
+}
+
+// overlaps returns whether or not path a is equal to or a parent directory of path b, or vice versa.
+func overlaps(a, b string) bool {
+	return a == b ||
+		strings.HasPrefix(b, a+string(os.PathSeparator)) ||
+		strings.HasPrefix(a, b+string(os.PathSeparator))
+}
+
+// isSameChange returns whether or not the local and remote changes have the same outcome.
+func (b *Bucket) isSameChange(l Change, r remoteChange) (bool, error) {
+	if l.Type == du.Remove || r.Type == du.Remove {
+		return l.Type == r.Type, nil
+	}
+	if l.Path != r.Path {
+		return false, nil
+	}
+	lc, err := b.repo.HashFile(l.Name)
+	if err != nil {
+		return false, err
+	}
+	return lc.Equals(r.cid), nil
+}
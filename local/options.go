@@ -68,6 +68,8 @@ type pathOptions struct {
 	confirm ConfirmDiffFunc
 	force   bool
 	hard    bool
+	merge   bool
+	resolve ResolveConflictFunc
 	events  chan<- Event
 }
 
@@ -98,6 +100,37 @@ func WithHard(b bool) PathOption {
 	}
 }
 
+// WithMerge indicates local and remote changes should be merged using the last
+// pushed or pulled remote root as the common ancestor.
+// Non-conflicting changes from both sides are applied. Conflicting paths are
+// resolved with the ResolveConflictFunc provided by WithResolveConflict,
+// or returned in a ConflictError if none was provided.
+func WithMerge(b bool) PathOption {
+	return func(args *pathOptions) {
+		args.merge = b
+	}
+}
+
+// ResolveConflictFunc is a caller-provided function which is used to resolve a merge conflict.
+type ResolveConflictFunc func(c Conflict) (ConflictStrategy, error)
+
+// ConflictStrategy describes the type of merge conflict resolution strategy.
+type ConflictStrategy string
+
+const (
+	// KeepLocal keeps the local change, discarding the remote change.
+	KeepLocal ConflictStrategy = "Keep local"
+	// KeepRemote keeps the remote change, discarding the local change.
+	KeepRemote ConflictStrategy = "Keep remote"
+)
+
+// WithResolveConflict allows the caller to resolve merge conflicts.
+func WithResolveConflict(f ResolveConflictFunc) PathOption {
+	return func(args *pathOptions) {
+		args.resolve = f
+	}
+}
+
 // WithEvents allows the caller to receive events when pushing or pulling files.
 func WithEvents(ch chan<- Event) PathOption {
 	return func(args *pathOptions) {
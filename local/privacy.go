@@ -0,0 +1,33 @@
+package local
+
+import (
+	"context"
+
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets"
+)
+
+// SetPrivacy converts the remote bucket between public and private in place.
+// The conversion is rejected if the remote has changed since the last push or pull.
+// Remote cids in the local repo are updated so that unchanged files are not pulled again.
+func (b *Bucket) SetPrivacy(ctx context.Context, private bool) (root path.Resolved, err error) {
+	b.Lock()
+	defer b.Unlock()
+	roots, err := b.Roots(ctx)
+	if err != nil {
+		return
+	}
+	ctx, err = b.authCtx(ctx)
+	if err != nil {
+		return
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return
+	}
+	res, err := b.c.SetPrivacy(ctx, id, b.Key(), private, buckets.WithFastForwardOnly(path.IpfsPath(roots.Remote)))
+	if err != nil {
+		return
+	}
+	return b.setRemoteRoot(ctx, res.Bucket.Path)
+}
@@ -12,6 +12,7 @@ import (
 	ds "github.com/ipfs/go-datastore"
 	du "github.com/ipfs/go-merkledag/dagutils"
 	"github.com/textileio/go-buckets"
+	pb "github.com/textileio/go-buckets/api/pb/buckets"
 	"github.com/textileio/go-buckets/collection"
 	"github.com/textileio/go-threads/core/thread"
 	"golang.org/x/sync/errgroup"
@@ -21,7 +22,8 @@ import (
 var MaxPullConcurrency = 10
 
 // PullRemote pulls remote files.
-// By default, only missing files are pulled. See PathOption for more info.
+// By default, only missing files are pulled and local changes take precedence over remote changes.
+// Use WithMerge to merge local and remote changes. See PathOption for more info.
 func (b *Bucket) PullRemote(ctx context.Context, opts ...PathOption) (roots Roots, err error) {
 	b.Lock()
 	defer b.Unlock()
@@ -33,13 +35,23 @@ func (b *Bucket) PullRemote(ctx context.Context, opts ...PathOption) (roots Root
 	for _, opt := range opts {
 		opt(args)
 	}
+	return b.pullRemote(ctx, args)
+}
 
+func (b *Bucket) pullRemote(ctx context.Context, args *pathOptions) (roots Roots, err error) {
 	diff, err := b.DiffLocal()
 	if errors.Is(err, ErrNotABucket) {
 		args.force = true
 	} else if err != nil {
 		return
 	}
+	if args.merge && !args.hard && !args.force && len(diff) > 0 {
+		// Drop local changes that are already on the remote or were resolved in favor of the remote
+		diff, err = b.mergeDiff(ctx, diff, args.resolve)
+		if err != nil {
+			return
+		}
+	}
 	if args.confirm != nil && args.hard && len(diff) > 0 {
 		if ok := args.confirm(diff); !ok {
 			return roots, ErrAborted
@@ -264,49 +276,56 @@ func (b *Bucket) listPath(
 	if err != nil {
 		return
 	}
-	rep, err := b.c.ListPath(ctx, id, b.Key(), pth)
+	it, err := b.c.ListPathStream(ctx, id, b.Key(), pth, buckets.WithListDepth(buckets.ListDepthUnlimited))
 	if err != nil {
 		return
 	}
-	if rep.Item.IsDir {
-		for _, i := range rep.Item.Items {
-			a, m, err := b.listPath(ctx, filepath.Join(pth, filepath.Base(i.Path)), dest, force)
-			if err != nil {
-				return nil, nil, err
-			}
-			all = append(all, a...)
-			missing = append(missing, m...)
+	defer it.Close()
+	for it.Next() {
+		item := it.Item()
+		if item.IsDir {
+			continue
 		}
-	} else {
-		name := filepath.Join(dest, pth)
-		c, err := cid.Decode(rep.Item.Cid)
+		o, skip, err := b.listObject(filepath.FromSlash(it.Cursor()), dest, item, force)
 		if err != nil {
 			return nil, nil, err
 		}
-		o := object{path: pth, name: name, size: rep.Item.Size, cid: c}
 		all = append(all, o)
-		if !force && b.repo != nil {
-			c, err := cid.Decode(rep.Item.Cid)
+		if !skip {
+			missing = append(missing, o)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, nil, err
+	}
+	return all, missing, nil
+}
+
+// listObject returns an object for a remote file item.
+// skip is true if the file already exists locally.
+func (b *Bucket) listObject(pth, dest string, item *pb.PathItem, force bool) (o object, skip bool, err error) {
+	name := filepath.Join(dest, pth)
+	c, err := cid.Decode(item.Cid)
+	if err != nil {
+		return
+	}
+	o = object{path: pth, name: name, size: item.Size, cid: c}
+	if !force && b.repo != nil {
+		lc, err := b.repo.HashFile(name)
+		if err == nil && lc.Equals(c) { // File exists, skip it
+			return o, true, nil
+		} else {
+			match, err := b.repo.MatchPath(pth, lc, c)
 			if err != nil {
-				return nil, nil, err
-			}
-			lc, err := b.repo.HashFile(name)
-			if err == nil && lc.Equals(c) { // File exists, skip it
-				return all, missing, nil
-			} else {
-				match, err := b.repo.MatchPath(pth, lc, c)
-				if err != nil {
-					if !errors.Is(err, ds.ErrNotFound) {
-						return nil, nil, err
-					}
-				} else if match { // File exists, skip it
-					return all, missing, nil
+				if !errors.Is(err, ds.ErrNotFound) {
+					return o, false, err
 				}
+			} else if match { // File exists, skip it
+				return o, true, nil
 			}
 		}
-		missing = append(missing, o)
 	}
-	return all, missing, nil
+	return o, false, nil
 }
 
 func (b *Bucket) getFile(ctx context.Context, id thread.ID, key string, o object, events chan<- Event, progress chan<- int64) error {
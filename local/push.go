@@ -2,19 +2,25 @@ package local
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	du "github.com/ipfs/go-merkledag/dagutils"
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/api/client"
 	"github.com/textileio/go-threads/core/thread"
 )
 
 // PushRemote pushes local files.
-// By default, only staged changes are pushed. See PathOption for more info.
+// By default, only staged changes are pushed and the push is rejected if the remote has changed.
+// Use WithMerge to merge remote changes before pushing. See PathOption for more info.
 func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots, err error) {
 	b.Lock()
 	defer b.Unlock()
@@ -31,6 +37,12 @@ func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots
 		opt(args)
 	}
 
+	if args.merge && !args.force && b.repo != nil {
+		if err := b.mergeRemote(ctx, args); err != nil {
+			return roots, err
+		}
+	}
+
 	diff, err := b.DiffLocal()
 	if errors.Is(err, ErrNotABucket) {
 		args.force = true
@@ -119,6 +131,33 @@ func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots
 	return b.Roots(ctx)
 }
 
+// mergeRemote pulls and merges remote changes if the remote root
+// has changed since the last push or pull.
+func (b *Bucket) mergeRemote(ctx context.Context, args *pathOptions) error {
+	_, xr, err := b.repo.Root()
+	if err != nil {
+		return err
+	}
+	rr, err := b.getRemoteRoot(ctx)
+	if err != nil {
+		return err
+	}
+	if !xr.Defined() || rr.Equals(xr) {
+		return nil
+	}
+	if _, err := b.pullRemote(ctx, &pathOptions{
+		merge:   true,
+		resolve: args.resolve,
+		events:  args.events,
+	}); errors.Is(err, ErrUpToDate) {
+		// The remote root changed without any file changes, e.g., roles were updated
+		return b.repo.SetRemotePath("", rr)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
 type pendingFile struct {
 	path string
 	rel  string
@@ -133,6 +172,9 @@ func (b *Bucket) addFiles(
 	force bool,
 	events chan<- Event,
 ) (path.Resolved, error) {
+	if len(changes) == 0 {
+		return xroot, nil
+	}
 	progress := make(chan int64)
 	defer close(progress)
 	files := make(map[string]pendingFile)
@@ -141,7 +183,7 @@ func (b *Bucket) addFiles(
 	if !force {
 		opts = append(opts, buckets.WithFastForwardOnly(xroot))
 	}
-	q, err := b.c.PushPaths(ctx, id, key, opts...)
+	q, fingerprint, err := b.pushPathsQueue(ctx, id, key, changes, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -201,9 +243,72 @@ func (b *Bucket) addFiles(
 			}
 		}
 	}
+	if b.repo != nil && fingerprint != "" {
+		if err := b.repo.RemoveUploadSession(); err != nil {
+			return nil, err
+		}
+	}
 	return root, nil
 }
 
+// pushPathsQueue returns a push queue for changes.
+// If the last push of the same changes was interrupted, its upload session is resumed.
+// The queue's upload session is saved to the local repo, along with a fingerprint of the changes.
+func (b *Bucket) pushPathsQueue(
+	ctx context.Context,
+	id thread.ID,
+	key string,
+	changes []Change,
+	opts []buckets.Option,
+) (*client.PushPathsQueue, string, error) {
+	if b.repo == nil {
+		q, err := b.c.PushPaths(ctx, id, key, opts...)
+		return q, "", err
+	}
+	fingerprint, err := changesFingerprint(changes)
+	if err != nil {
+		return nil, "", err
+	}
+	session, xfingerprint, err := b.repo.UploadSession()
+	if err != nil {
+		return nil, "", err
+	}
+	var q *client.PushPathsQueue
+	if session != "" && fingerprint == xfingerprint {
+		q, err = b.c.PushPaths(ctx, id, key, append(opts, buckets.WithResumeUploadSession(session))...)
+		if err != nil && !strings.Contains(err.Error(), buckets.ErrUploadSessionNotFound.Error()) {
+			return nil, "", err
+		}
+	}
+	if q == nil {
+		q, err = b.c.PushPaths(ctx, id, key, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if err := b.repo.SetUploadSession(q.Session(), fingerprint); err != nil {
+		q.Close()
+		return nil, "", err
+	}
+	return q, fingerprint, nil
+}
+
+// changesFingerprint returns a hash of the names, sizes, and modification times of changed files.
+// Resuming an upload session is only safe if the files have not changed since the session started.
+func changesFingerprint(changes []Change) (string, error) {
+	lines := make([]string, len(changes))
+	for i, c := range changes {
+		info, err := os.Stat(c.Name)
+		if err != nil {
+			return "", err
+		}
+		lines[i] = fmt.Sprintf("%s:%d:%d", filepath.ToSlash(c.Path), info.Size(), info.ModTime().UnixNano())
+	}
+	sort.Strings(lines)
+	sum := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(sum[:]), nil
+}
+
 func (b *Bucket) rmFile(
 	ctx context.Context,
 	id thread.ID,
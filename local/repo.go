@@ -44,6 +44,9 @@ var (
 	// patchExt is used to ignore tmp files during a pull.
 	patchExt = ".buckpatch"
 
+	// uploadSessionKey is used to store the last interrupted upload session.
+	uploadSessionKey = ds.NewKey("UPLOADSESSION")
+
 	// ignoredFilenames is a list of default ignored file names.
 	ignoredFilenames = []string{
 		".DS_Store",
@@ -56,6 +59,12 @@ type pathMap struct {
 	Remote cid.Cid
 }
 
+// uploadSession holds details about an interrupted upload session.
+type uploadSession struct {
+	ID          string
+	Fingerprint string
+}
+
 // Repo tracks a local bucket tree structure.
 type Repo struct {
 	path   string
@@ -217,12 +226,23 @@ func (b *Repo) recursiveAddPath(
 	if err != nil {
 		return nil, nil, err
 	}
+	ig, err := newIgnorer(b.path)
+	if err != nil {
+		return nil, nil, err
+	}
 	if err = filepath.Walk(abs, func(n string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
+		ignored, err := ig.Ignored(n, info.IsDir())
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && ignored {
+			return filepath.SkipDir
+		}
 		if !info.IsDir() {
-			if Ignore(n) {
+			if ignored {
 				return nil
 			}
 			p := n
@@ -369,6 +389,43 @@ func (b *Repo) Diff(ctx context.Context, pth string) (diff []*du.Change, err err
 	return Diff(ctx, tmp, an, bn)
 }
 
+// ListPaths returns the file paths saved in the bucket.
+func (b *Repo) ListPaths(ctx context.Context) ([]string, error) {
+	lc, _, err := b.Root()
+	if err != nil {
+		return nil, err
+	}
+	if !lc.Defined() {
+		return nil, nil
+	}
+	n, err := b.dag.Get(ctx, lc)
+	if err != nil {
+		return nil, err
+	}
+	return b.listPaths(ctx, n, "")
+}
+
+// listPaths recursively lists the file paths under node.
+func (b *Repo) listPaths(ctx context.Context, nd ipld.Node, pth string) ([]string, error) {
+	fn, err := unixfs.ExtractFSNode(nd)
+	if err != nil || !fn.IsDir() { // Raw nodes are always files
+		return []string{pth}, nil
+	}
+	var paths []string
+	for _, l := range nd.Links() {
+		ln, err := l.GetNode(ctx, b.dag)
+		if err != nil {
+			return nil, err
+		}
+		lp, err := b.listPaths(ctx, ln, path.Join(pth, l.Name))
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, lp...)
+	}
+	return paths, nil
+}
+
 // SetRemotePath sets or creates a mapping from a local path to a remote cid.
 func (b *Repo) SetRemotePath(pth string, remote cid.Cid) error {
 	k, err := getPathKey(pth)
@@ -423,6 +480,42 @@ func (b *Repo) RemovePath(ctx context.Context, pth string) error {
 	return b.ds.Delete(k)
 }
 
+// UploadSession returns the ID of the last interrupted upload session
+// and the fingerprint of the changes it was pushing.
+// An empty ID is returned if there's no interrupted session.
+func (b *Repo) UploadSession() (id, fingerprint string, err error) {
+	v, err := b.ds.Get(uploadSessionKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		return "", "", nil
+	} else if err != nil {
+		return
+	}
+	dec := gob.NewDecoder(bytes.NewReader(v))
+	var us uploadSession
+	if err = dec.Decode(&us); err != nil {
+		return
+	}
+	return us.ID, us.Fingerprint, nil
+}
+
+// SetUploadSession saves an upload session so it can be resumed if interrupted.
+func (b *Repo) SetUploadSession(id, fingerprint string) error {
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(uploadSession{ID: id, Fingerprint: fingerprint}); err != nil {
+		return err
+	}
+	return b.ds.Put(uploadSessionKey, buf.Bytes())
+}
+
+// RemoveUploadSession removes the saved upload session.
+func (b *Repo) RemoveUploadSession() error {
+	if err := b.ds.Delete(uploadSessionKey); err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
 // Close closes the store and blocks service.
 func (b *Repo) Close() error {
 	if err := b.ds.Close(); err != nil {
@@ -434,7 +527,8 @@ func (b *Repo) Close() error {
 	return nil
 }
 
-// Ignore returns true if the path contains an ignored file.
+// Ignore returns true if the path contains a default ignored file name.
+// Patterns in .buckignore files are also honored when walking local bucket paths.
 func Ignore(pth string) bool {
 	for _, n := range ignoredFilenames {
 		if strings.HasSuffix(pth, n) {
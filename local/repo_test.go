@@ -278,6 +278,56 @@ func TestRepo_RemovePath(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestRepo_Ignore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		IgnoreFileName:          "# build output\nbuild/\n*.swp\n!keep.swp\n/top.txt\n",
+		"file.txt":              "file",
+		"file.txt.swp":          "swap",
+		"keep.swp":              "keep",
+		"top.txt":               "top",
+		"build/out.bin":         "out",
+		"sub/top.txt":           "nested top",
+		"sub/" + IgnoreFileName: "*.log\n",
+		"sub/debug.log":         "log",
+		"debug.log":             "root log",
+		"sub/deep/trace.log":    "trace",
+	}
+	for n, c := range files {
+		n = filepath.Join(dir, filepath.FromSlash(n))
+		err := os.MkdirAll(filepath.Dir(n), os.ModePerm)
+		require.NoError(t, err)
+		err = ioutil.WriteFile(n, []byte(c), 0644)
+		require.NoError(t, err)
+	}
+
+	repo := makeRepo(t, dir, options.BalancedLayout)
+	defer repo.Close()
+	err = repo.Save(context.Background())
+	require.NoError(t, err)
+
+	paths, err := repo.ListPaths(context.Background())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		IgnoreFileName,
+		"file.txt",
+		"keep.swp",
+		"sub/top.txt",
+		"sub/" + IgnoreFileName,
+		"debug.log",
+	}, paths)
+
+	// Adding an ignored file should not show up in the diff
+	err = ioutil.WriteFile(filepath.Join(dir, "build", "new.bin"), []byte("new"), 0644)
+	require.NoError(t, err)
+	diff, err := repo.Diff(context.Background(), dir)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
 func makeRepo(t *testing.T, root string, layout options.Layout) *Repo {
 	repo, err := NewRepo(root, ".textile/repo", layout)
 	require.NoError(t, err)
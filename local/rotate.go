@@ -0,0 +1,69 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/util"
+)
+
+// RotateKeys re-encrypts the remote private bucket with a new link key.
+// Use buckets.WithRotateFileKeys to also replace every file encryption key.
+// The rotation is rejected if the remote has changed since the last push or pull.
+// Remote cids in the local repo are updated so that unchanged files are not pulled again.
+func (b *Bucket) RotateKeys(ctx context.Context, opts ...buckets.RotateKeysOption) (root path.Resolved, err error) {
+	b.Lock()
+	defer b.Unlock()
+	roots, err := b.Roots(ctx)
+	if err != nil {
+		return
+	}
+	ctx, err = b.authCtx(ctx)
+	if err != nil {
+		return
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return
+	}
+	opts = append(opts, buckets.WithRotateRoot(path.IpfsPath(roots.Remote)))
+	res, err := b.c.RotateKeys(ctx, id, b.Key(), opts...)
+	if err != nil {
+		return
+	}
+	return b.setRemoteRoot(ctx, res.Bucket.Path)
+}
+
+// setRemoteRoot updates the local repo after the remote dag was re-written without changing content.
+// The remote cids of files that are already tracked are replaced with the new remote cids.
+func (b *Bucket) setRemoteRoot(ctx context.Context, rpth string) (root path.Resolved, err error) {
+	root, err = util.NewResolvedPath(rpth)
+	if err != nil {
+		return
+	}
+	if b.repo == nil {
+		return root, nil
+	}
+	if err = b.repo.SetRemotePath("", root.Cid()); err != nil {
+		return
+	}
+	if err = b.WalkRemotePath(ctx, "", func(rel string, item BucketItem) error {
+		if item.IsDir {
+			return nil
+		}
+		pth := filepath.FromSlash(rel)
+		if _, _, err := b.repo.GetPathMap(pth); errors.Is(err, ds.ErrNotFound) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		return b.repo.SetRemotePath(pth, item.Cid)
+	}); err != nil {
+		return
+	}
+	return root, nil
+}
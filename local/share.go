@@ -0,0 +1,48 @@
+package local
+
+import (
+	"context"
+
+	pb "github.com/textileio/go-buckets/api/pb/buckets"
+	"github.com/textileio/go-buckets/share"
+)
+
+// CreateShareLink issues a read-only link to a remote bucket path that expires.
+// Use share.WithTTL and share.WithMaxDownloads to limit the link.
+func (b *Bucket) CreateShareLink(ctx context.Context, pth string, opts ...share.Option) (*pb.ShareLink, error) {
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return nil, err
+	}
+	return b.c.CreateShareLink(ctx, id, b.Key(), pth, opts...)
+}
+
+// ListShareLinks returns the share links issued by the bucket identity.
+func (b *Bucket) ListShareLinks(ctx context.Context) ([]*pb.ShareLink, error) {
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return nil, err
+	}
+	return b.c.ListShareLinks(ctx, id, b.Key())
+}
+
+// RevokeShareLink revokes a share link issued by the bucket identity.
+func (b *Bucket) RevokeShareLink(ctx context.Context, linkID string) error {
+	ctx, err := b.authCtx(ctx)
+	if err != nil {
+		return err
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return err
+	}
+	return b.c.RevokeShareLink(ctx, id, b.Key(), linkID)
+}
@@ -0,0 +1,82 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/textileio/go-buckets"
+)
+
+// VerifyReport describes the result of verifying a bucket.
+type VerifyReport struct {
+	buckets.VerifyReport
+	// Local lists remote files whose cids differ from the remote cids recorded in the local repo.
+	// Differences are expected if the local bucket is not up-to-date with the remote.
+	Local []buckets.VerifyError `json:"local"`
+}
+
+// OK returns whether or not the remote bucket passed verification and matches the local repo.
+func (r *VerifyReport) OK() bool {
+	return r.VerifyReport.OK() && len(r.Local) == 0
+}
+
+// Verify walks the remote bucket dag, confirming that every block is retrievable and intact,
+// and that private bucket nodes decrypt with their keys.
+// Remote files are also compared with the remote cids recorded in the local repo.
+func (b *Bucket) Verify(ctx context.Context) (report *VerifyReport, err error) {
+	ctx, err = b.authCtx(ctx)
+	if err != nil {
+		return
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return
+	}
+	rep, err := b.c.Verify(ctx, id, b.Key())
+	if err != nil {
+		return
+	}
+	report = &VerifyReport{VerifyReport: *rep}
+	if b.repo == nil {
+		return report, nil
+	}
+
+	_, rc, err := b.repo.Root()
+	if err != nil {
+		return
+	}
+	if rc.Defined() && !rc.Equals(rep.Root) {
+		report.Local = append(report.Local, buckets.VerifyError{
+			Path:   "",
+			Cid:    rep.Root,
+			Reason: fmt.Sprintf("local repo root is %s", rc),
+		})
+	}
+	for _, item := range rep.Items {
+		if item.IsDir {
+			continue
+		}
+		_, remote, err := b.repo.GetPathMap(filepath.FromSlash(item.Path))
+		if errors.Is(err, ds.ErrNotFound) {
+			report.Local = append(report.Local, buckets.VerifyError{
+				Path:   item.Path,
+				Cid:    item.Cid,
+				Reason: "missing from local repo",
+			})
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if !remote.Equals(item.Cid) {
+			report.Local = append(report.Local, buckets.VerifyError{
+				Path:   item.Path,
+				Cid:    item.Cid,
+				Reason: fmt.Sprintf("local repo remote cid is %s", remote),
+			})
+		}
+	}
+	return report, nil
+}
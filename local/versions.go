@@ -0,0 +1,50 @@
+package local
+
+import (
+	"context"
+
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/collection"
+)
+
+// ListVersions returns the remote bucket's root history, most recent first.
+func (b *Bucket) ListVersions(ctx context.Context) (versions []collection.Root, err error) {
+	ctx, err = b.authCtx(ctx)
+	if err != nil {
+		return
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return
+	}
+	return b.c.ListVersions(ctx, id, b.Key())
+}
+
+// RestoreVersion sets the remote bucket root to a version from the bucket's history.
+// version can be a root path or cid.
+// The restore is rejected if the remote has changed since the last push or pull.
+// Use PullRemote to update the local bucket after a restore.
+func (b *Bucket) RestoreVersion(ctx context.Context, version string) (root path.Resolved, err error) {
+	b.Lock()
+	defer b.Unlock()
+	roots, err := b.Roots(ctx)
+	if err != nil {
+		return
+	}
+	ctx, err = b.authCtx(ctx)
+	if err != nil {
+		return
+	}
+	id, err := b.Thread()
+	if err != nil {
+		return
+	}
+	return b.c.RestoreVersion(
+		ctx,
+		id,
+		b.Key(),
+		version,
+		buckets.WithFastForwardOnly(path.IpfsPath(roots.Remote)),
+	)
+}
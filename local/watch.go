@@ -3,123 +3,301 @@ package local
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/cmd"
 )
 
 const (
-	fileSystemWatchInterval = time.Millisecond * 100
+	fileSystemWatchInterval = time.Millisecond * 500
 	reconnectInterval       = time.Second * 5
 )
 
-//// Watch watches for and auto-pushes local bucket changes at an interval,
-//// and listens for and auto-pulls remote changes as they arrive.
-//// Use the WithOffline option to keep watching during network interruptions.
-//// Returns a channel of watch connectivity states.
-//// Cancel context to stop watching.
-//func (b *Bucket) Watch(ctx context.Context, opts ...WatchOption) (<-chan cmd.WatchState, error) {
-//	ctx, err := b.auth(ctx)
-//	if err != nil {
-//		return nil, err
-//	}
-//	args := &watchOptions{}
-//	for _, opt := range opts {
-//		opt(args)
-//	}
-//	if !args.offline {
-//		return b.watchWhileConnected(ctx, args.events)
-//	}
-//	return cmd.Watch(ctx, func(ctx context.Context) (<-chan cmd.WatchState, error) {
-//		return b.watchWhileConnected(ctx, args.events)
-//	}, reconnectInterval)
-//}
-//
-//// watchWhileConnected will watch until context is canceled or an error occurs.
-//func (b *Bucket) watchWhileConnected(ctx context.Context, pevents chan<- Event) (<-chan cmd.WatchState, error) {
-//	id, err := b.Thread()
-//	if err != nil {
-//		return nil, err
-//	}
-//	bp, err := b.Path()
-//	if err != nil {
-//		return nil, err
-//	}
-//
-//	state := make(chan cmd.WatchState)
-//	go func() {
-//		defer close(state)
-//		w := watcher.New()
-//		defer w.Close()
-//		w.SetMaxEvents(1)
-//		if err := w.AddRecursive(bp); err != nil {
-//			state <- cmd.WatchState{Err: err, Aborted: true}
-//			return
-//		}
-//
-//		// Start listening for remote changes
-//		events, err := b.clients.Threads.Listen(ctx, id, []client.ListenOption{{
-//			Type:       client.ListenAll,
-//			InstanceID: b.Key(),
-//		}})
-//		if err != nil {
-//			state <- cmd.WatchState{Err: err, Aborted: !cmd.IsConnectionError(err)}
-//			return
-//		}
-//		errs := make(chan error)
-//		go func() {
-//			for e := range events {
-//				if e.Err != nil {
-//					errs <- e.Err // events will close on error
-//				} else if err := b.watchPull(ctx, pevents); err != nil {
-//					errs <- err
-//					return
-//				}
-//			}
-//		}()
-//
-//		// Start listening for local changes
-//		go func() {
-//			if err := w.Start(fileSystemWatchInterval); err != nil {
-//				errs <- err
-//			}
-//		}()
-//		go func() {
-//			for {
-//				select {
-//				case <-w.Event:
-//					if err := b.watchPush(ctx, pevents); err != nil {
-//						errs <- err
-//					}
-//				case err := <-w.Error:
-//					errs <- err
-//				case <-w.Closed:
-//					return
-//				}
-//			}
-//		}()
-//
-//		// Manually sync once on startup
-//		if err := b.watchPush(ctx, pevents); err != nil {
-//			state <- cmd.WatchState{Err: err, Aborted: !cmd.IsConnectionError(err)}
-//			return
-//		}
-//
-//		// If we made it here, we must be online
-//		state <- cmd.WatchState{State: cmd.Online}
-//
-//		for {
-//			select {
-//			case err := <-errs:
-//				state <- cmd.WatchState{Err: err, Aborted: !cmd.IsConnectionError(err)}
-//				return
-//			case <-ctx.Done():
-//				return
-//			}
-//		}
-//	}()
-//	return state, nil
-//}
+// Watch watches for and auto-pushes local bucket changes as they happen,
+// and listens for and auto-pulls remote changes as they arrive.
+// Local changes are detected with file system notifications, falling back to
+// polling at an interval if notifications are not available.
+// Use the WithOffline option to keep watching during network interruptions.
+// Returns a channel of watch connectivity states.
+// Cancel context to stop watching.
+func (b *Bucket) Watch(ctx context.Context, opts ...WatchOption) (<-chan cmd.WatchState, error) {
+	args := &watchOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	if !args.offline {
+		return b.watchWhileConnected(ctx, args.events)
+	}
+	return cmd.Watch(ctx, func(ctx context.Context) (<-chan cmd.WatchState, error) {
+		return b.watchWhileConnected(ctx, args.events)
+	}, reconnectInterval)
+}
+
+// watchWhileConnected will watch until context is canceled or an error occurs.
+func (b *Bucket) watchWhileConnected(ctx context.Context, pevents chan<- Event) (<-chan cmd.WatchState, error) {
+	id, err := b.Thread()
+	if err != nil {
+		return nil, err
+	}
+	bp, err := b.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	state := make(chan cmd.WatchState)
+	go func() {
+		defer close(state)
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		sendState := func(s cmd.WatchState) {
+			select {
+			case state <- s:
+			case <-ctx.Done():
+			}
+		}
+
+		// Start listening for remote changes
+		actx, err := b.authCtx(ctx)
+		if err != nil {
+			sendState(cmd.WatchState{Err: err, Aborted: true})
+			return
+		}
+		events, err := b.c.Listen(actx, id, b.Key())
+		if err != nil {
+			sendState(cmd.WatchState{Err: err, Aborted: !cmd.IsConnectionError(err)})
+			return
+		}
+		errs := make(chan error, 1)
+		go func() {
+			for e := range events {
+				if e.Err != nil {
+					errs <- e.Err // events will close on error
+					return
+				} else if e.Event.Type == buckets.EventBucketRemoved {
+					errs <- errors.New("remote bucket was removed")
+					return
+				} else if err := b.watchPull(ctx, pevents); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+
+		// Start watching for local changes
+		changes, lerrs := b.watchLocal(ctx, bp)
+
+		// Manually sync once on startup
+		if err := b.watchPush(ctx, pevents); err != nil {
+			sendState(cmd.WatchState{Err: err, Aborted: !cmd.IsConnectionError(err)})
+			return
+		}
+
+		// If we made it here, we must be online
+		sendState(cmd.WatchState{State: cmd.Online})
+
+		for {
+			select {
+			case <-changes:
+				if err := b.watchPush(ctx, pevents); err != nil {
+					sendState(cmd.WatchState{Err: err, Aborted: !cmd.IsConnectionError(err)})
+					return
+				}
+			case err := <-lerrs:
+				sendState(cmd.WatchState{Err: err, Aborted: true})
+				return
+			case err := <-errs:
+				sendState(cmd.WatchState{Err: err, Aborted: !cmd.IsConnectionError(err)})
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return state, nil
+}
+
+// watchLocal signals on the returned channel when local files in bucket path bp change.
+// Bursts of changes are coalesced into a single signal.
+// File system notifications are used if available, otherwise bp is polled.
+// Watching stops when context is canceled or an error is sent on the returned error channel.
+func (b *Bucket) watchLocal(ctx context.Context, bp string) (<-chan struct{}, <-chan error) {
+	changes := make(chan struct{}, 1)
+	errs := make(chan error, 1)
+	signal := func() {
+		select {
+		case changes <- struct{}{}:
+		default: // A change is already pending
+		}
+	}
+	w, err := b.newFSWatcher(bp)
+	if err != nil {
+		// Notifications may be unsupported or limited, e.g., by max_user_watches on Linux
+		go b.pollLocal(ctx, bp, signal, errs)
+	} else {
+		go b.notifyLocal(ctx, w, bp, signal, errs)
+	}
+	return changes, errs
+}
+
+// newFSWatcher returns a file system watcher for all directories in bucket path bp.
+func (b *Bucket) newFSWatcher(bp string) (*fsnotify.Watcher, error) {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := b.addWatchDirs(w, bp, bp); err != nil {
+		w.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// addWatchDirs adds pth and its directories to w, skipping ignored directories and the config directory.
+func (b *Bucket) addWatchDirs(w *fsnotify.Watcher, bp, pth string) error {
+	ig, err := newIgnorer(bp)
+	if err != nil {
+		return err
+	}
+	confDir := filepath.Join(bp, b.conf.Dir)
+	return filepath.Walk(pth, func(n string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) {
+			return nil // Removed during the walk
+		} else if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		ignored, err := ig.Ignored(n, true)
+		if err != nil {
+			return err
+		}
+		if ignored || n == confDir {
+			return filepath.SkipDir
+		}
+		return w.Add(n)
+	})
+}
+
+// notifyLocal signals changes reported by w until context is canceled or an error occurs.
+// New directories are added to w as they are created.
+func (b *Bucket) notifyLocal(
+	ctx context.Context,
+	w *fsnotify.Watcher,
+	bp string,
+	signal func(),
+	errs chan<- error,
+) {
+	defer w.Close()
+	confDir := filepath.Join(bp, b.conf.Dir)
+	var settled <-chan time.Time
+	for {
+		select {
+		case e, ok := <-w.Events:
+			if !ok {
+				return
+			}
+			if e.Name == confDir || strings.HasPrefix(e.Name, confDir+string(os.PathSeparator)) {
+				continue
+			}
+			if e.Op&fsnotify.Create != 0 {
+				if info, err := os.Stat(e.Name); err == nil && info.IsDir() {
+					if err := b.addWatchDirs(w, bp, e.Name); err != nil {
+						errs <- err
+						return
+					}
+				}
+			}
+			// Wait for changes to settle before signaling
+			settled = time.After(fileSystemWatchInterval)
+		case <-settled:
+			settled = nil
+			signal()
+		case err, ok := <-w.Errors:
+			if !ok {
+				return
+			}
+			errs <- err
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// pollLocal signals changes found by snapshotting bucket path bp at an interval
+// until context is canceled or an error occurs.
+func (b *Bucket) pollLocal(ctx context.Context, bp string, signal func(), errs chan<- error) {
+	snap, err := b.snapshot(bp)
+	if err != nil {
+		errs <- err
+		return
+	}
+	ticker := time.NewTicker(fileSystemWatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			next, err := b.snapshot(bp)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if !next.equal(snap) {
+				snap = next
+				signal()
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// fileState describes the state of a local file.
+type fileState struct {
+	size    int64
+	modTime time.Time
+}
+
+// fsSnapshot maps local file names to their state.
+type fsSnapshot map[string]fileState
+
+// equal returns whether or not the snapshots contain the same files in the same state.
+func (s fsSnapshot) equal(o fsSnapshot) bool {
+	if len(s) != len(o) {
+		return false
+	}
+	for n, f := range s {
+		if of, ok := o[n]; !ok || of.size != f.size || !of.modTime.Equal(f.modTime) {
+			return false
+		}
+	}
+	return true
+}
+
+// snapshot returns the state of all files in the bucket path.
+func (b *Bucket) snapshot(bp string) (fsSnapshot, error) {
+	names, err := b.walkPath(bp)
+	if err != nil {
+		return nil, err
+	}
+	snap := make(fsSnapshot)
+	for _, n := range names {
+		info, err := os.Stat(n)
+		if os.IsNotExist(err) {
+			continue // Removed during the walk
+		} else if err != nil {
+			return nil, err
+		}
+		snap[n] = fileState{size: info.Size(), modTime: info.ModTime()}
+	}
+	return snap, nil
+}
 
 func (b *Bucket) watchPush(ctx context.Context, events chan<- Event) error {
 	b.pushBlock <- struct{}{}
@@ -128,13 +306,18 @@ func (b *Bucket) watchPush(ctx context.Context, events chan<- Event) error {
 	}()
 	if _, err := b.PushLocal(ctx, WithEvents(events)); errors.Is(err, ErrUpToDate) {
 		return nil
-	} else if errors.Is(err, buckets.ErrNonFastForward) {
-		// Pull remote changes
-		if _, err = b.PullRemote(ctx, WithEvents(events)); err != nil {
+	} else if err != nil && strings.Contains(err.Error(), buckets.ErrNonFastForward.Error()) {
+		// Pull remote changes, keeping local changes on conflict
+		if _, err = b.PullRemote(
+			ctx,
+			WithEvents(events),
+			WithMerge(true),
+			WithResolveConflict(keepLocal),
+		); err != nil && !errors.Is(err, ErrUpToDate) {
 			return err
 		}
 		// Now try pushing again
-		if _, err = b.PushLocal(ctx, WithEvents(events)); err != nil {
+		if _, err = b.PushLocal(ctx, WithEvents(events)); err != nil && !errors.Is(err, ErrUpToDate) {
 			return err
 		}
 	} else if err != nil {
@@ -143,16 +326,17 @@ func (b *Bucket) watchPush(ctx context.Context, events chan<- Event) error {
 	return nil
 }
 
+func keepLocal(Conflict) (ConflictStrategy, error) {
+	return KeepLocal, nil
+}
+
 func (b *Bucket) watchPull(ctx context.Context, events chan<- Event) error {
-	select {
-	case b.pushBlock <- struct{}{}:
-		if _, err := b.PullRemote(ctx, WithEvents(events)); !errors.Is(err, ErrUpToDate) {
-			<-b.pushBlock
-			return err
-		}
+	b.pushBlock <- struct{}{}
+	defer func() {
 		<-b.pushBlock
-	default:
-		// Ignore if there's a push in progress
+	}()
+	if _, err := b.PullRemote(ctx, WithEvents(events)); err != nil && !errors.Is(err, ErrUpToDate) {
+		return err
 	}
 	return nil
 }
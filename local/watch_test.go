@@ -0,0 +1,114 @@
+package local_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-buckets/cmd"
+	. "github.com/textileio/go-buckets/local"
+)
+
+func TestBucket_Watch(t *testing.T) {
+	buckets := setup(t)
+	buck, err := buckets.NewBucket(context.Background(), getConf(t))
+	require.NoError(t, err)
+	addRandomFile(t, buck, "shared", 1024)
+	addRandomFile(t, buck, "conflict", 1024)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	conf2 := Config{Path: newDir(t)}
+	conf2.Key = buck.Key()
+	conf2.Thread, err = buck.Thread()
+	require.NoError(t, err)
+	conf2.Identity, err = buck.Identity()
+	require.NoError(t, err)
+	buck2, err := buckets.NewBucket(context.Background(), conf2)
+	require.NoError(t, err)
+	bp2, err := buck2.Path()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	state, err := buck2.Watch(ctx)
+	require.NoError(t, err)
+	select {
+	case s := <-state:
+		require.NoError(t, s.Err)
+		require.Equal(t, cmd.Online, s.State)
+	case <-time.After(time.Second * 10):
+		t.Fatal("timed out waiting for watch to come online")
+	}
+	errs := make(chan error, 1)
+	go func() {
+		for s := range state {
+			if s.Err != nil {
+				errs <- s.Err
+				return
+			}
+		}
+	}()
+
+	// Local changes are pushed
+	lpth := addRandomFile(t, buck2, "folder/local", 1024)
+	waitForRemote(t, buck, errs, "folder/local", lpth)
+
+	// Remote changes are pulled
+	_, err = buck.PullRemote(context.Background())
+	require.NoError(t, err)
+	rpth := addRandomFile(t, buck, "remote", 1024)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+	waitForLocal(t, errs, rpth, filepath.Join(bp2, "remote"))
+
+	// Conflicts are resolved by keeping the local change
+	cpth := addRandomFile(t, buck2, "conflict", 1024)
+	addRandomFile(t, buck, "conflict", 1024)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+	waitForRemote(t, buck, errs, "conflict", cpth)
+}
+
+// waitForRemote waits until the remote file at pth matches the local file at name.
+func waitForRemote(t *testing.T, buck *Bucket, errs <-chan error, pth, name string) {
+	want, err := ioutil.ReadFile(name)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		select {
+		case err := <-errs:
+			assert.NoError(t, err)
+			return true
+		default:
+		}
+		buf := new(bytes.Buffer)
+		if err := buck.CatRemotePath(context.Background(), pth, buf); err != nil {
+			return false
+		}
+		return bytes.Equal(want, buf.Bytes())
+	}, time.Second*10, time.Millisecond*100)
+}
+
+// waitForLocal waits until the local file at name matches the local file at src.
+func waitForLocal(t *testing.T, errs <-chan error, src, name string) {
+	want, err := ioutil.ReadFile(src)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		select {
+		case err := <-errs:
+			assert.NoError(t, err)
+			return true
+		default:
+		}
+		got, err := ioutil.ReadFile(name)
+		if err != nil {
+			return false
+		}
+		return bytes.Equal(want, got)
+	}, time.Second*10, time.Millisecond*100)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets/audit"
 	"github.com/textileio/go-buckets/collection"
 	"github.com/textileio/go-buckets/dag"
 	"github.com/textileio/go-threads/core/did"
@@ -18,59 +19,103 @@ func (b *Buckets) MovePath(
 	ctx context.Context,
 	thread core.ID,
 	key, fpth, tpth string,
+	root path.Resolved,
 	identity did.Token,
 ) (int64, *Bucket, error) {
+	caller, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+
 	lk := b.locks.Get(lock(key))
 	lk.Acquire()
 	defer lk.Release()
 
-	fpth, err := parsePath(fpth)
+	ctx, instance, err := b.getSafe(ctx, thread, key, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+	ctx = dag.NewOwnerContext(ctx, instance.Owner)
+	if root != nil && root.String() != instance.Path {
+		return 0, nil, ErrNonFastForward
+	}
+
+	oldRoot := instance.Path
+	ctx, dest, err := b.applyMovePath(ctx, thread, instance, fpth, tpth, identity)
 	if err != nil {
 		return 0, nil, err
 	}
+	ctx, err = b.addVersion(ctx, instance, caller, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	b.changes.add(thread, instance, caller, oldRoot, change{typ: EventPathMoved, path: trimSlash(fpth), toPath: dest})
+	if err := b.saveAndPublish(ctx, thread, instance, identity); err != nil {
+		return 0, nil, err
+	}
+	b.record(ctx, thread, key, caller, audit.OpMovePath, []string{fpth, dest}, oldRoot, instance.Path)
+
+	log.Debugf("moved %s to %s", fpth, tpth)
+	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, view(ctx, thread, instance)), nil
+}
+
+// applyMovePath moves fpth to tpth in the bucket instance without saving it.
+// The returned destination path differs from tpth if fpth is moved into an existing directory.
+func (b *Buckets) applyMovePath(
+	ctx context.Context,
+	thread core.ID,
+	instance *collection.Bucket,
+	fpth, tpth string,
+	identity did.Token,
+) (context.Context, string, error) {
+	fpth, err := parsePath(fpth)
+	if err != nil {
+		return ctx, "", err
+	}
 	if fpth == "" {
 		// @todo: enable move of root directory
-		return 0, nil, fmt.Errorf("root cannot be moved")
+		return ctx, "", fmt.Errorf("root cannot be moved")
 	}
 	tpth, err = parsePath(tpth)
 	if err != nil {
-		return 0, nil, err
+		return ctx, "", err
 	}
 	// Paths are the same, nothing to do
 	if fpth == tpth {
-		return 0, nil, fmt.Errorf("path is destination")
+		return ctx, "", fmt.Errorf("path is destination")
 	}
 
-	instance, pth, err := b.getBucketAndPath(ctx, thread, key, fpth, identity)
+	pth, err := getBucketPath(instance, fpth)
 	if err != nil {
-		return 0, nil, fmt.Errorf("getting path: %v", err)
+		return ctx, "", fmt.Errorf("getting path: %v", err)
 	}
 
 	instance.UpdatedAt = time.Now().UnixNano()
+	attrs := instance.TakeAttributesWithPrefix(fpth)
 	instance.SetMetadataAtPath(tpth, collection.Metadata{
 		UpdatedAt: instance.UpdatedAt,
 	})
 	instance.UnsetMetadataWithPrefix(fpth + "/")
-	if err := b.c.Verify(ctx, thread, instance, collection.WithIdentity(identity)); err != nil {
-		return 0, nil, fmt.Errorf("verifying bucket update: %v", err)
+	if err := b.verify(ctx, thread, instance, identity); err != nil {
+		return ctx, "", fmt.Errorf("verifying bucket update: %v", err)
 	}
 
 	fbpth, err := getBucketPath(instance, fpth)
 	if err != nil {
-		return 0, nil, err
+		return ctx, "", err
 	}
 	fitem, err := b.pathToItem(ctx, instance, fbpth, false)
 	if err != nil {
-		return 0, nil, err
+		return ctx, "", err
 	}
 	tbpth, err := getBucketPath(instance, tpth)
 	if err != nil {
-		return 0, nil, err
+		return ctx, "", err
 	}
 	titem, err := b.pathToItem(ctx, instance, tbpth, false)
 	if err == nil {
 		if fitem.IsDir && !titem.IsDir {
-			return 0, nil, fmt.Errorf("destination is not a directory")
+			return ctx, "", fmt.Errorf("destination is not a directory")
 		}
 		if titem.IsDir {
 			// from => to becomes new dir:
@@ -79,17 +124,18 @@ func (b *Buckets) MovePath(
 			tpth = gopath.Join(tpth, fitem.Name)
 		}
 	}
+	instance.SetAttributesWithPrefix(tpth, attrs, instance.UpdatedAt)
 
 	pnode, err := dag.GetNodeAtPath(ctx, b.ipfs, pth, instance.GetLinkEncryptionKey())
 	if err != nil {
-		return 0, nil, fmt.Errorf("getting node: %v", err)
+		return ctx, "", fmt.Errorf("getting node: %v", err)
 	}
 
 	var dirPath path.Resolved
 	if instance.IsPrivate() {
-		ctx, dirPath, err = dag.CopyDag(ctx, b.ipfs, instance, pnode, fpth, tpth)
+		ctx, dirPath, err = dag.CopyDag(ctx, b.ipfs, b.pinner, instance, pnode, fpth, tpth)
 		if err != nil {
-			return 0, nil, fmt.Errorf("copying node: %v", err)
+			return ctx, "", fmt.Errorf("copying node: %v", err)
 		}
 	} else {
 		ctx, dirPath, err = b.setPathFromExistingCid(
@@ -102,7 +148,7 @@ func (b *Buckets) MovePath(
 			nil,
 		)
 		if err != nil {
-			return 0, nil, fmt.Errorf("copying path: %v", err)
+			return ctx, "", fmt.Errorf("copying path: %v", err)
 		}
 	}
 	instance.Path = dirPath.String()
@@ -112,25 +158,19 @@ func (b *Buckets) MovePath(
 		if instance.IsPrivate() {
 			ctx, dirPath, err = b.removePath(ctx, instance, fpth)
 			if err != nil {
-				return 0, nil, fmt.Errorf("removing path: %v", err)
+				return ctx, "", fmt.Errorf("removing path: %v", err)
 			}
 			instance.Path = dirPath.String()
 		}
-
-		if err := b.saveAndPublish(ctx, thread, instance, identity); err != nil {
-			return 0, nil, err
-		}
-
-		log.Debugf("moved %s to %s", fpth, tpth)
-		return dag.GetPinnedBytes(ctx), instanceToBucket(thread, instance), nil
+		return ctx, tpth, nil
 	}
 
 	if strings.HasPrefix(tpth, fpth) {
 		// If "a/" => "a/b" cleanup each leaf in "a" that isn't "b" (skipping .textileseed)
 		ppth := path.Join(path.New(instance.Path), fpth)
-		item, err := b.listPath(ctx, instance, ppth)
+		item, _, err := b.listPath(ctx, instance, ppth)
 		if err != nil {
-			return 0, nil, fmt.Errorf("listing path: %v", err)
+			return ctx, "", fmt.Errorf("listing path: %v", err)
 		}
 		for _, chld := range item.Items {
 			sp := trimSlash(movePathRegexp.ReplaceAllString(chld.Path, ""))
@@ -139,7 +179,7 @@ func (b *Buckets) MovePath(
 			}
 			ctx, dirPath, err = b.removePath(ctx, instance, trimSlash(sp))
 			if err != nil {
-				return 0, nil, fmt.Errorf("removing path: %v", err)
+				return ctx, "", fmt.Errorf("removing path: %v", err)
 			}
 			instance.Path = dirPath.String()
 		}
@@ -147,15 +187,9 @@ func (b *Buckets) MovePath(
 		// if a/ => b/ remove a
 		ctx, dirPath, err = b.removePath(ctx, instance, fpth)
 		if err != nil {
-			return 0, nil, fmt.Errorf("removing path: %v", err)
+			return ctx, "", fmt.Errorf("removing path: %v", err)
 		}
 		instance.Path = dirPath.String()
 	}
-
-	if err := b.saveAndPublish(ctx, thread, instance, identity); err != nil {
-		return 0, nil, err
-	}
-
-	log.Debugf("moved %s to %s", fpth, tpth)
-	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, instance), nil
+	return ctx, tpth, nil
 }
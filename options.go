@@ -2,10 +2,61 @@ package buckets
 
 import (
 	c "github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	core "github.com/textileio/go-threads/core/thread"
 )
 
+const (
+	// DefaultPinnedVersions is the default number of replaced bucket roots that remain pinned.
+	DefaultPinnedVersions = 3
+	// DefaultMaxVersions is the default maximum number of roots kept in bucket history.
+	DefaultMaxVersions = 100
+)
+
+type BucketsOptions struct {
+	PinnedVersions     int
+	MaxVersions        int
+	UploadSessionStore ds.Datastore
+	ServerIdentity     core.Identity
+}
+
+type BucketsOption func(*BucketsOptions)
+
+// WithPinnedVersions sets the number of replaced bucket roots that remain pinned.
+// Older roots are kept in bucket history but can no longer be restored.
+// Zero disables restoring versions.
+func WithPinnedVersions(n int) BucketsOption {
+	return func(args *BucketsOptions) {
+		args.PinnedVersions = n
+	}
+}
+
+// WithMaxVersions sets the maximum number of roots kept in bucket history.
+func WithMaxVersions(n int) BucketsOption {
+	return func(args *BucketsOptions) {
+		args.MaxVersions = n
+	}
+}
+
+// WithUploadSessionStore sets the datastore used to persist upload sessions across restarts.
+// Sessions are kept in memory by default.
+func WithUploadSessionStore(store ds.Datastore) BucketsOption {
+	return func(args *BucketsOptions) {
+		args.UploadSessionStore = store
+	}
+}
+
+// WithServerIdentity sets the identity used for maintenance reads of all buckets, e.g., by Fsck.
+// The identity can read every bucket instance unfiltered, so its key must be kept secret.
+// Use the same identity across restarts to avoid updating the read filter of every bucket collection.
+// A new identity is generated by default.
+func WithServerIdentity(identity core.Identity) BucketsOption {
+	return func(args *BucketsOptions) {
+		args.ServerIdentity = identity
+	}
+}
+
 type CreateOptions struct {
 	Thread  core.ID
 	Name    string
@@ -44,9 +95,161 @@ func WithCid(cid c.Cid) CreateOption {
 	}
 }
 
-type Options struct {
+type ForkOptions struct {
+	Name    string
+	Private bool
+	Roles   bool
+}
+
+type ForkOption func(*ForkOptions)
+
+// WithForkName sets a name for the forked bucket.
+// The source bucket name is used by default.
+func WithForkName(name string) ForkOption {
+	return func(args *ForkOptions) {
+		args.Name = name
+	}
+}
+
+// WithForkPrivate specifies that an encryption key will be used for the forked bucket.
+// Forks of private buckets are always private.
+func WithForkPrivate(private bool) ForkOption {
+	return func(args *ForkOptions) {
+		args.Private = private
+	}
+}
+
+// WithForkRoles indicates that access roles should be copied from the source bucket.
+// By default, access roles are reset and the caller becomes the sole admin of the fork.
+func WithForkRoles(roles bool) ForkOption {
+	return func(args *ForkOptions) {
+		args.Roles = roles
+	}
+}
+
+type ImportOptions struct {
+	Name           string
+	Private        bool
+	RegenerateKeys bool
+}
+
+type ImportOption func(*ImportOptions)
+
+// WithImportName sets a name for the imported bucket.
+// The archived bucket name is used by default.
+func WithImportName(name string) ImportOption {
+	return func(args *ImportOptions) {
+		args.Name = name
+	}
+}
+
+// WithImportPrivate specifies that an encryption key will be used for the imported bucket.
+// Imports of private buckets are always private.
+func WithImportPrivate(private bool) ImportOption {
+	return func(args *ImportOptions) {
+		args.Private = private
+	}
+}
+
+// WithImportRegenerateKeys indicates that new encryption keys should be created for the imported bucket.
+// By default, the archived keys are re-used.
+func WithImportRegenerateKeys(regenerate bool) ImportOption {
+	return func(args *ImportOptions) {
+		args.RegenerateKeys = regenerate
+	}
+}
+
+type RotateKeysOptions struct {
+	FileKeys bool
 	Root     path.Resolved
-	Progress chan<- int64
+}
+
+type RotateKeysOption func(*RotateKeysOptions)
+
+// WithRotateFileKeys indicates that all file encryption keys should be rotated along with the link key.
+// By default, only the link key is rotated.
+func WithRotateFileKeys(rotate bool) RotateKeysOption {
+	return func(args *RotateKeysOptions) {
+		args.FileKeys = rotate
+	}
+}
+
+// WithRotateRoot sets the expected current root of the bucket.
+// The rotation fails with ErrNonFastForward if the bucket root has changed.
+func WithRotateRoot(root path.Resolved) RotateKeysOption {
+	return func(args *RotateKeysOptions) {
+		args.Root = root
+	}
+}
+
+type PushPathsOptions struct {
+	UploadSession string
+}
+
+type PushPathsOption func(*PushPathsOptions)
+
+// WithUploadSession records received data in an upload session so that an interrupted push can be resumed.
+// See NewUploadSession for more.
+func WithUploadSession(id string) PushPathsOption {
+	return func(args *PushPathsOptions) {
+		args.UploadSession = id
+	}
+}
+
+// ListDepthUnlimited lists all levels below a path.
+const ListDepthUnlimited = -1
+
+type ListPathOptions struct {
+	Depth  int
+	Limit  int
+	Cursor string
+}
+
+type ListPathOption func(*ListPathOptions)
+
+// WithListDepth sets the number of levels below the path to list.
+// Use ListDepthUnlimited to list the entire tree. One level is listed by default.
+func WithListDepth(depth int) ListPathOption {
+	return func(args *ListPathOptions) {
+		args.Depth = depth
+	}
+}
+
+// WithListLimit sets the maximum number of items to list below the path.
+// Items are counted in depth-first name order. All items are listed by default.
+func WithListLimit(limit int) ListPathOption {
+	return func(args *ListPathOptions) {
+		args.Limit = limit
+	}
+}
+
+// WithListCursor resumes listing after the cursor returned with a previous page of items.
+func WithListCursor(cursor string) ListPathOption {
+	return func(args *ListPathOptions) {
+		args.Cursor = cursor
+	}
+}
+
+type FsckOptions struct {
+	Fix bool
+}
+
+type FsckOption func(*FsckOptions)
+
+// WithFsckFix repairs discrepancies instead of only reporting them.
+// Missing pins are added. Orphaned pins are removed only if every bucket could be fully checked.
+func WithFsckFix(fix bool) FsckOption {
+	return func(args *FsckOptions) {
+		args.Fix = fix
+	}
+}
+
+type Options struct {
+	Root          path.Resolved
+	Progress      chan<- int64
+	UploadSession string
+	Offset        int64
+	Length        int64
 }
 
 type Option func(*Options)
@@ -64,3 +267,21 @@ func WithProgress(ch chan<- int64) Option {
 		args.Progress = ch
 	}
 }
+
+// WithResumeUploadSession resumes the upload session with id instead of starting a new one.
+// Files that were already saved by the session are skipped and partially received files
+// are resumed from the last received byte.
+func WithResumeUploadSession(id string) Option {
+	return func(args *Options) {
+		args.UploadSession = id
+	}
+}
+
+// WithRange pulls length bytes of a file starting at offset.
+// A length of zero pulls to the end of the file.
+func WithRange(offset, length int64) Option {
+	return func(args *Options) {
+		args.Offset = offset
+		args.Length = length
+	}
+}
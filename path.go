@@ -2,6 +2,7 @@ package buckets
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	gopath "path"
 	"strings"
@@ -31,16 +32,91 @@ func trimSlash(pth string) string {
 	return strings.TrimPrefix(pth, "/")
 }
 
-// listPaths returns a PathItem describing the bucket path.
-func (b *Buckets) listPath(ctx context.Context, bucket *collection.Bucket, pth path.Path) (*PathItem, error) {
-	item, err := b.pathToItem(ctx, bucket, pth, true)
+// listPath returns a PathItem describing the bucket path, including descendants up to the depth option.
+// If a limit option is set, the returned cursor can be used to list the next page of descendants.
+// Parents of descendants in later pages are listed again so that items remain nested.
+func (b *Buckets) listPath(
+	ctx context.Context,
+	bucket *collection.Bucket,
+	pth path.Path,
+	opts ...ListPathOption,
+) (*PathItem, string, error) {
+	args := newListPathOptions(opts)
+	linkKey := bucket.GetLinkEncryptionKey()
+	n, err := dag.GetNodeAtPath(ctx, b.ipfs, pth, linkKey)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	var (
+		stack      []*treeItem
+		count      int
+		last, next string
+	)
+	w := &walker{
+		b:        b,
+		instance: bucket,
+		key:      linkKey,
+		cursor:   args.Cursor,
+		depth:    args.Depth,
+		seeds:    true,
 	}
+	rel := trimSlash(strings.TrimPrefix(pth.String(), bucket.Path))
+	if err := w.walk(ctx, n, pth.String(), rel, 0, func(rel string, item *PathItem, depth int, after bool) error {
+		stack = append(stack[:depth], &treeItem{item: item})
+		if depth == 0 || !after {
+			return nil
+		}
+		if args.Limit > 0 && count == args.Limit {
+			next = last
+			return errWalkDone
+		}
+		count++
+		last = rel
+		// Attach the item and any parents that were not yet attached
+		for i := depth; i > 0 && !stack[i].attached; i-- {
+			stack[i-1].children = append(stack[i-1].children, stack[i])
+			stack[i].attached = true
+		}
+		return nil
+	}); err != nil && !errors.Is(err, errWalkDone) {
+		return nil, "", err
+	}
+
+	item := stack[0].toItem()
 	if pth.String() == bucket.Path {
 		item.Name = bucket.Name
 	}
-	return item, nil
+	return &item, next, nil
+}
+
+// newListPathOptions returns list options with defaults applied.
+func newListPathOptions(opts []ListPathOption) *ListPathOptions {
+	args := &ListPathOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	if args.Depth == 0 {
+		args.Depth = 1
+	}
+	args.Cursor = trimSlash(args.Cursor)
+	return args
+}
+
+// treeItem is a node in a tree of path items.
+type treeItem struct {
+	item     *PathItem
+	children []*treeItem
+	attached bool
+}
+
+// toItem returns the path item with nested child items.
+func (t *treeItem) toItem() PathItem {
+	item := *t.item
+	for _, c := range t.children {
+		item.Items = append(item.Items, c.toItem())
+	}
+	return item
 }
 
 // pathToItem returns items at path, optionally including one level down of links.
@@ -96,6 +172,7 @@ func (b *Buckets) nodeToItem(
 		if !ok {
 			return nil, fmt.Errorf("could not resolve path: %s", pth)
 		}
+		md.Attributes = bucket.Metadata[name].Attributes // Attributes are not inherited
 		item.Metadata = md
 	}
 
@@ -141,6 +218,7 @@ func (b *Buckets) removePath(
 		ctx, dirPath, err = dag.RemoveNodeAtPath(
 			ctx,
 			b.ipfs,
+			b.pinner,
 			path.Join(bpth, pth),
 			instance.GetLinkEncryptionKey(),
 		)
@@ -152,7 +230,7 @@ func (b *Buckets) removePath(
 		if err != nil {
 			return ctx, nil, err
 		}
-		ctx, err = dag.UpdateOrAddPin(ctx, b.ipfs, bpth, dirPath)
+		ctx, err = dag.UpdateOrAddPin(ctx, b.ipfs, b.pinner, bpth, dirPath)
 		if err != nil {
 			return ctx, nil, fmt.Errorf("update pin failed: %v", err)
 		}
@@ -161,13 +239,20 @@ func (b *Buckets) removePath(
 }
 
 // getBucketAndPath returns a bucket instance and full bucket path.
+// If ctx carries a Grant, the instance is read on behalf of the grant instead of identity.
 func (b *Buckets) getBucketAndPath(
 	ctx context.Context,
 	thread core.ID,
 	key, pth string,
 	identity did.Token,
 ) (*collection.Bucket, path.Path, error) {
-	instance, err := b.c.GetSafe(ctx, thread, key, collection.WithIdentity(identity))
+	var instance *collection.Bucket
+	var err error
+	if grant, ok := grantFromContext(ctx); ok {
+		instance, err = b.getBucketWithGrant(ctx, thread, key, pth, grant)
+	} else {
+		instance, err = b.getView(ctx, thread, key, identity)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -199,7 +284,7 @@ func (b *Buckets) setPathFromExistingCid(
 		if err != nil {
 			return ctx, nil, err
 		}
-		ctx, dirPath, err = dag.CreateBucketPathWithCid(ctx, b.ipfs, destPath, cid, linkKey, fileKey, sn)
+		ctx, dirPath, err = dag.CreateBucketPathWithCid(ctx, b.ipfs, b.pinner, destPath, cid, linkKey, fileKey, sn)
 		if err != nil {
 			return ctx, nil, fmt.Errorf("generating bucket new root: %v", err)
 		}
@@ -208,12 +293,12 @@ func (b *Buckets) setPathFromExistingCid(
 			if err != nil {
 				return ctx, nil, fmt.Errorf("resolving path: %v", err)
 			}
-			ctx, err = dag.UnpinNodeAndBranch(ctx, b.ipfs, buckPathResolved, linkKey)
+			ctx, err = dag.UnpinNodeAndBranch(ctx, b.ipfs, b.pinner, buckPathResolved, linkKey)
 			if err != nil {
 				return ctx, nil, fmt.Errorf("unpinning pinned root: %v", err)
 			}
 		} else {
-			ctx, err = dag.UnpinPath(ctx, b.ipfs, buckPath)
+			ctx, err = dag.UnpinPath(ctx, b.ipfs, b.pinner, buckPath)
 			if err != nil {
 				return ctx, nil, fmt.Errorf("updating pinned root: %v", err)
 			}
@@ -234,11 +319,11 @@ func (b *Buckets) setPathFromExistingCid(
 			if err != nil {
 				return ctx, nil, fmt.Errorf("resolving remote path: %v", err)
 			}
-			ctx, dirPath, err = dag.InsertNodeAtPath(ctx, b.ipfs, n, path.Join(buckPath, destPath), linkKey)
+			ctx, dirPath, err = dag.InsertNodeAtPath(ctx, b.ipfs, b.pinner, n, path.Join(buckPath, destPath), linkKey)
 			if err != nil {
 				return ctx, nil, fmt.Errorf("updating pinned root: %v", err)
 			}
-			ctx, err = dag.AddAndPinNodes(ctx, b.ipfs, nodes)
+			ctx, err = dag.AddAndPinNodes(ctx, b.ipfs, b.pinner, nodes)
 			if err != nil {
 				return ctx, nil, err
 			}
@@ -254,7 +339,7 @@ func (b *Buckets) setPathFromExistingCid(
 			if err != nil {
 				return ctx, nil, fmt.Errorf("adding folder: %v", err)
 			}
-			ctx, err = dag.UpdateOrAddPin(ctx, b.ipfs, buckPath, dirPath)
+			ctx, err = dag.UpdateOrAddPin(ctx, b.ipfs, b.pinner, buckPath, dirPath)
 			if err != nil {
 				return ctx, nil, fmt.Errorf("updating pinned root: %v", err)
 			}
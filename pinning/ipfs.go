@@ -0,0 +1,135 @@
+package pinning
+
+import (
+	"context"
+
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+)
+
+// pinNotRecursiveMsg is used to match an IPFS "recursively pinned already" error.
+const pinNotRecursiveMsg = "'from' cid was not recursively pinned already"
+
+var ipfsPrefix = ds.NewKey("/pinning/ipfs")
+
+// IPFSPinner pins data on an IPFS node.
+// Pins are recorded in a datastore so that pins held by other users of the node
+// are never listed, which makes it safe to share the node.
+type IPFSPinner struct {
+	ipfs  iface.CoreAPI
+	store ds.Datastore
+}
+
+var _ Pinner = (*IPFSPinner)(nil)
+
+// NewIPFSPinner returns a Pinner backed by an IPFS node.
+// Pins created by the Pinner are recorded in store.
+func NewIPFSPinner(ipfs iface.CoreAPI, store ds.Datastore) *IPFSPinner {
+	return &IPFSPinner{ipfs: ipfs, store: store}
+}
+
+func (p *IPFSPinner) Add(ctx context.Context, c cid.Cid) error {
+	if err := p.ipfs.Pin().Add(ctx, path.IpfsPath(c)); err != nil {
+		return err
+	}
+	return p.store.Put(ipfsPrefix.ChildString(c.String()), []byte{})
+}
+
+func (p *IPFSPinner) Update(ctx context.Context, from, to cid.Cid) error {
+	if err := p.ipfs.Pin().Update(ctx, path.IpfsPath(from), path.IpfsPath(to)); err != nil {
+		if err.Error() == pinNotRecursiveMsg {
+			return p.Add(ctx, to)
+		}
+		return err
+	}
+	if err := p.store.Delete(ipfsPrefix.ChildString(from.String())); err != nil {
+		return err
+	}
+	return p.store.Put(ipfsPrefix.ChildString(to.String()), []byte{})
+}
+
+func (p *IPFSPinner) Rm(ctx context.Context, c cid.Cid) error {
+	if err := p.ipfs.Pin().Rm(ctx, path.IpfsPath(c)); err != nil {
+		return err
+	}
+	return p.store.Delete(ipfsPrefix.ChildString(c.String()))
+}
+
+func (p *IPFSPinner) IsPinned(ctx context.Context, c cid.Cid) (bool, error) {
+	_, pinned, err := p.ipfs.Pin().IsPinned(ctx, path.IpfsPath(c))
+	return pinned, err
+}
+
+// Seed records all recursive pins on the node as created by the Pinner if no pins have been recorded yet.
+// This adopts pins created before pins were recorded, when buckets were pinned directly on the node.
+// Pins held by other users of the node are adopted as well, so only seed a node that was not shared.
+// It returns the number of adopted pins.
+func (p *IPFSPinner) Seed(ctx context.Context) (int, error) {
+	res, err := p.store.Query(query.Query{Prefix: ipfsPrefix.String(), KeysOnly: true, Limit: 1})
+	if err != nil {
+		return 0, err
+	}
+	existing, err := res.Rest()
+	if err != nil {
+		return 0, err
+	}
+	if len(existing) > 0 {
+		return 0, nil
+	}
+
+	pins, err := p.ipfs.Pin().Ls(ctx, options.Pin.Ls.Recursive())
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	for pin := range pins {
+		if err := pin.Err(); err != nil {
+			return count, err
+		}
+		if err := p.store.Put(ipfsPrefix.ChildString(pin.Path().Cid().String()), []byte{}); err != nil {
+			return count, err
+		}
+		count++
+	}
+	log.Infof("adopted %d existing pins", count)
+	return count, nil
+}
+
+// Ls returns the recursive pins on the node that were created by the Pinner.
+func (p *IPFSPinner) Ls(ctx context.Context) ([]cid.Cid, error) {
+	res, err := p.store.Query(query.Query{Prefix: ipfsPrefix.String(), KeysOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	created := make(map[cid.Cid]struct{})
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		c, err := cid.Decode(ds.RawKey(r.Key).BaseNamespace())
+		if err != nil {
+			return nil, err
+		}
+		created[c] = struct{}{}
+	}
+
+	pins, err := p.ipfs.Pin().Ls(ctx, options.Pin.Ls.Recursive())
+	if err != nil {
+		return nil, err
+	}
+	var cids []cid.Cid
+	for pin := range pins {
+		if err := pin.Err(); err != nil {
+			return nil, err
+		}
+		if _, ok := created[pin.Path().Cid()]; ok {
+			cids = append(cids, pin.Path().Cid())
+		}
+	}
+	return cids, nil
+}
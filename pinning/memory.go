@@ -0,0 +1,64 @@
+package pinning
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ipfs/go-cid"
+)
+
+// MemoryPinner tracks pins in memory without persisting any data.
+// It's useful for tests.
+type MemoryPinner struct {
+	pins map[cid.Cid]struct{}
+	lk   sync.Mutex
+}
+
+var _ Pinner = (*MemoryPinner)(nil)
+
+// NewMemoryPinner returns a new in-memory Pinner.
+func NewMemoryPinner() *MemoryPinner {
+	return &MemoryPinner{pins: make(map[cid.Cid]struct{})}
+}
+
+func (p *MemoryPinner) Add(_ context.Context, c cid.Cid) error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	p.pins[c] = struct{}{}
+	return nil
+}
+
+func (p *MemoryPinner) Update(_ context.Context, from, to cid.Cid) error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	delete(p.pins, from)
+	p.pins[to] = struct{}{}
+	return nil
+}
+
+func (p *MemoryPinner) Rm(_ context.Context, c cid.Cid) error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	if _, ok := p.pins[c]; !ok {
+		return ErrNotPinned
+	}
+	delete(p.pins, c)
+	return nil
+}
+
+func (p *MemoryPinner) IsPinned(_ context.Context, c cid.Cid) (bool, error) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	_, ok := p.pins[c]
+	return ok, nil
+}
+
+func (p *MemoryPinner) Ls(_ context.Context) ([]cid.Cid, error) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	cids := make([]cid.Cid, 0, len(p.pins))
+	for c := range p.pins {
+		cids = append(cids, c)
+	}
+	return cids, nil
+}
@@ -0,0 +1,33 @@
+package pinning
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ipfs/go-cid"
+	logging "github.com/ipfs/go-log/v2"
+)
+
+var (
+	log = logging.Logger("buckets-pinning")
+
+	// ErrNotPinned indicates that a cid is not pinned.
+	ErrNotPinned = errors.New("not pinned")
+)
+
+// Pinner persists bucket data by pinning dag roots.
+// All pins are recursive.
+type Pinner interface {
+	// Add pins the dag at c.
+	Add(ctx context.Context, c cid.Cid) error
+	// Update moves the pin at from to to.
+	// If from is not pinned, a new pin is placed at to.
+	Update(ctx context.Context, from, to cid.Cid) error
+	// Rm removes the pin at c.
+	Rm(ctx context.Context, c cid.Cid) error
+	// IsPinned returns whether or not c is pinned.
+	IsPinned(ctx context.Context, c cid.Cid) (bool, error)
+	// Ls returns all cids pinned by the Pinner.
+	// Pins held by other users of the same backend are not included.
+	Ls(ctx context.Context) ([]cid.Cid, error)
+}
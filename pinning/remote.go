@@ -0,0 +1,284 @@
+package pinning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-cid"
+)
+
+const (
+	// remoteMaxLimit is the maximum number of results returned by the pinning service in a single page.
+	remoteMaxLimit = 1000
+	// remoteActiveStatus matches pin requests that have not failed.
+	remoteActiveStatus = "queued,pinning,pinned"
+)
+
+// RemoteConfig configures a RemotePinner.
+type RemoteConfig struct {
+	// Endpoint is the Pinning Service API endpoint, e.g., https://pinning-service.example.com.
+	Endpoint string
+	// Token is the pinning service access token.
+	Token string
+	// Origins are multiaddrs of nodes that hold the data to be pinned, e.g., the buckets IPFS node.
+	Origins []string
+	// Name is an optional name added to each pin request.
+	// If set, only pins with this name are listed, updated, or removed, so the service account
+	// can be shared with other users.
+	Name string
+	// Client is the HTTP client used for requests. Defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// RemotePinner pins data with a remote service that implements the IPFS Pinning Service API.
+// See https://ipfs.github.io/pinning-services-api-spec.
+// Pin requests are processed asynchronously by the service.
+// A cid is considered pinned as soon as the service has accepted a request for it.
+type RemotePinner struct {
+	conf     RemoteConfig
+	endpoint *url.URL
+	client   *http.Client
+}
+
+var _ Pinner = (*RemotePinner)(nil)
+
+// NewRemotePinner returns a Pinner backed by a remote pinning service.
+func NewRemotePinner(conf RemoteConfig) (*RemotePinner, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(conf.Endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing endpoint: %v", err)
+	}
+	if endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint: %s", conf.Endpoint)
+	}
+	client := conf.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &RemotePinner{
+		conf:     conf,
+		endpoint: endpoint,
+		client:   client,
+	}, nil
+}
+
+// remotePin is a pinning service pin object.
+type remotePin struct {
+	Cid     string   `json:"cid"`
+	Name    string   `json:"name,omitempty"`
+	Origins []string `json:"origins,omitempty"`
+}
+
+// remotePinStatus is a pinning service pin status object.
+type remotePinStatus struct {
+	RequestID string    `json:"requestid"`
+	Status    string    `json:"status"`
+	Created   time.Time `json:"created"`
+	Pin       remotePin `json:"pin"`
+}
+
+// remotePinResults is a page of pinning service pin status objects.
+type remotePinResults struct {
+	Count   int               `json:"count"`
+	Results []remotePinStatus `json:"results"`
+}
+
+// remoteError is a pinning service error response.
+type remoteError struct {
+	Error struct {
+		Reason  string `json:"reason"`
+		Details string `json:"details"`
+	} `json:"error"`
+}
+
+func (p *RemotePinner) Add(ctx context.Context, c cid.Cid) error {
+	pins, err := p.find(ctx, c)
+	if err != nil {
+		return err
+	}
+	if len(pins) != 0 {
+		return nil
+	}
+	return p.do(ctx, http.MethodPost, "/pins", nil, p.newPin(c), nil)
+}
+
+func (p *RemotePinner) Update(ctx context.Context, from, to cid.Cid) error {
+	pins, err := p.find(ctx, from)
+	if err != nil {
+		return err
+	}
+	if len(pins) == 0 {
+		return p.Add(ctx, to)
+	}
+	if err := p.do(ctx, http.MethodPost, "/pins/"+pins[0].RequestID, nil, p.newPin(to), nil); err != nil {
+		return err
+	}
+	for _, pin := range pins[1:] {
+		if err := p.do(ctx, http.MethodDelete, "/pins/"+pin.RequestID, nil, nil, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *RemotePinner) Rm(ctx context.Context, c cid.Cid) error {
+	pins, err := p.find(ctx, c)
+	if err != nil {
+		return err
+	}
+	if len(pins) == 0 {
+		return ErrNotPinned
+	}
+	for _, pin := range pins {
+		if err := p.do(ctx, http.MethodDelete, "/pins/"+pin.RequestID, nil, nil, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *RemotePinner) IsPinned(ctx context.Context, c cid.Cid) (bool, error) {
+	pins, err := p.find(ctx, c)
+	if err != nil {
+		return false, err
+	}
+	return len(pins) != 0, nil
+}
+
+func (p *RemotePinner) Ls(ctx context.Context) ([]cid.Cid, error) {
+	var (
+		cids     []cid.Cid
+		seen     = make(map[cid.Cid]struct{})
+		requests = make(map[string]struct{})
+		before   time.Time
+	)
+	for {
+		q := url.Values{}
+		q.Set("status", remoteActiveStatus)
+		q.Set("limit", strconv.Itoa(remoteMaxLimit))
+		if p.conf.Name != "" {
+			q.Set("name", p.conf.Name)
+		}
+		if !before.IsZero() {
+			q.Set("before", before.Format(time.RFC3339Nano))
+		}
+		var res remotePinResults
+		if err := p.do(ctx, http.MethodGet, "/pins", q, nil, &res); err != nil {
+			return nil, err
+		}
+		var added int
+		for _, pin := range res.Results {
+			if _, ok := requests[pin.RequestID]; ok {
+				continue
+			}
+			requests[pin.RequestID] = struct{}{}
+			added++
+			c, err := cid.Decode(pin.Pin.Cid)
+			if err != nil {
+				return nil, fmt.Errorf("decoding cid: %v", err)
+			}
+			if _, ok := seen[c]; !ok {
+				seen[c] = struct{}{}
+				cids = append(cids, c)
+			}
+		}
+		if len(res.Results) == 0 || len(res.Results) >= res.Count {
+			return cids, nil
+		}
+		if added == 0 {
+			return nil, fmt.Errorf("too many pins created at %s to list", before.Format(time.RFC3339Nano))
+		}
+		// Results are sorted by creation time, most recent first.
+		// The before filter is exclusive, so the next page starts just after the oldest result
+		// to include pins created at the same time. Results already seen are skipped.
+		before = res.Results[len(res.Results)-1].Created.Add(time.Nanosecond)
+	}
+}
+
+// find returns active pin requests for c that were created by the pinner.
+func (p *RemotePinner) find(ctx context.Context, c cid.Cid) ([]remotePinStatus, error) {
+	q := url.Values{}
+	q.Set("cid", c.String())
+	q.Set("status", remoteActiveStatus)
+	q.Set("limit", strconv.Itoa(remoteMaxLimit))
+	if p.conf.Name != "" {
+		q.Set("name", p.conf.Name)
+	}
+	var res remotePinResults
+	if err := p.do(ctx, http.MethodGet, "/pins", q, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Results, nil
+}
+
+func (p *RemotePinner) newPin(c cid.Cid) *remotePin {
+	return &remotePin{
+		Cid:     c.String(),
+		Name:    p.conf.Name,
+		Origins: p.conf.Origins,
+	}
+}
+
+// do sends a request to the pinning service.
+// If out is not nil, the response body is decoded into it.
+func (p *RemotePinner) do(
+	ctx context.Context,
+	method, pth string,
+	query url.Values,
+	body, out interface{},
+) error {
+	u := *p.endpoint
+	u.Path += pth
+	u.RawQuery = query.Encode()
+
+	var r io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), r)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if p.conf.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.conf.Token)
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %v", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		var rerr remoteError
+		if err := json.NewDecoder(res.Body).Decode(&rerr); err != nil || rerr.Error.Reason == "" {
+			return fmt.Errorf("pinning service: %s", res.Status)
+		}
+		if rerr.Error.Details != "" {
+			return fmt.Errorf("pinning service: %s: %s", rerr.Error.Reason, rerr.Error.Details)
+		}
+		return fmt.Errorf("pinning service: %s", rerr.Error.Reason)
+	}
+	log.Debugf("%s %s: %s", method, pth, res.Status)
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return fmt.Errorf("decoding response: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package pinning_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/go-buckets/pinning"
+)
+
+func TestRemotePinner(t *testing.T) {
+	srv := httptest.NewServer(newPinningService(t, "secret", 1000))
+	t.Cleanup(srv.Close)
+	p, err := NewRemotePinner(RemoteConfig{
+		Endpoint: srv.URL,
+		Token:    "secret",
+		Origins:  []string{"/ip4/127.0.0.1/tcp/4001"},
+	})
+	require.NoError(t, err)
+	testPinner(t, p)
+
+	t.Run("unauthorized", func(t *testing.T) {
+		p, err := NewRemotePinner(RemoteConfig{Endpoint: srv.URL})
+		require.NoError(t, err)
+		_, err = p.IsPinned(context.Background(), newCid(t, "foo"))
+		require.Error(t, err)
+	})
+
+	t.Run("shared account", func(t *testing.T) {
+		newNamed := func(name string) *RemotePinner {
+			p, err := NewRemotePinner(RemoteConfig{
+				Endpoint: srv.URL,
+				Token:    "secret",
+				Origins:  []string{"/ip4/127.0.0.1/tcp/4001"},
+				Name:     name,
+			})
+			require.NoError(t, err)
+			return p
+		}
+		ctx := context.Background()
+		mine, other := newNamed("mine"), newNamed("other")
+		c1, c2 := newCid(t, "mine"), newCid(t, "other")
+		err := mine.Add(ctx, c1)
+		require.NoError(t, err)
+		err = other.Add(ctx, c2)
+		require.NoError(t, err)
+
+		pins, err := mine.Ls(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []cid.Cid{c1}, pins)
+		err = mine.Rm(ctx, c2)
+		require.ErrorIs(t, err, ErrNotPinned)
+		pinned, err := other.IsPinned(ctx, c2)
+		require.NoError(t, err)
+		assert.True(t, pinned)
+	})
+
+	t.Run("paginated ls", func(t *testing.T) {
+		srv := httptest.NewServer(newPinningService(t, "secret", 3))
+		t.Cleanup(srv.Close)
+		p, err := NewRemotePinner(RemoteConfig{
+			Endpoint: srv.URL,
+			Token:    "secret",
+			Origins:  []string{"/ip4/127.0.0.1/tcp/4001"},
+		})
+		require.NoError(t, err)
+
+		// Pins are created in pairs that share a timestamp, so a page boundary splits a pair
+		ctx := context.Background()
+		var added []cid.Cid
+		for i := 0; i < 7; i++ {
+			c := newCid(t, fmt.Sprintf("page%d", i))
+			err := p.Add(ctx, c)
+			require.NoError(t, err)
+			added = append(added, c)
+		}
+		pins, err := p.Ls(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, added, pins)
+	})
+}
+
+func TestMemoryPinner(t *testing.T) {
+	testPinner(t, NewMemoryPinner())
+}
+
+func testPinner(t *testing.T, p Pinner) {
+	ctx := context.Background()
+	c1, c2, c3 := newCid(t, "one"), newCid(t, "two"), newCid(t, "three")
+
+	err := p.Add(ctx, c1)
+	require.NoError(t, err)
+	err = p.Add(ctx, c1)
+	require.NoError(t, err)
+	pinned, err := p.IsPinned(ctx, c1)
+	require.NoError(t, err)
+	assert.True(t, pinned)
+
+	err = p.Update(ctx, c1, c2)
+	require.NoError(t, err)
+	pinned, err = p.IsPinned(ctx, c1)
+	require.NoError(t, err)
+	assert.False(t, pinned)
+	pinned, err = p.IsPinned(ctx, c2)
+	require.NoError(t, err)
+	assert.True(t, pinned)
+
+	// Updating from an unpinned cid adds a new pin
+	err = p.Update(ctx, c1, c3)
+	require.NoError(t, err)
+	pins, err := p.Ls(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []cid.Cid{c2, c3}, pins)
+
+	err = p.Rm(ctx, c2)
+	require.NoError(t, err)
+	err = p.Rm(ctx, c2)
+	require.ErrorIs(t, err, ErrNotPinned)
+	pins, err = p.Ls(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []cid.Cid{c3}, pins)
+}
+
+func newCid(t *testing.T, data string) cid.Cid {
+	h, err := multihash.Sum([]byte(data), multihash.SHA2_256, -1)
+	require.NoError(t, err)
+	return cid.NewCidV1(cid.Raw, h)
+}
+
+type pinStatus struct {
+	RequestID string    `json:"requestid"`
+	Status    string    `json:"status"`
+	Created   time.Time `json:"created"`
+	Pin       struct {
+		Cid     string   `json:"cid"`
+		Name    string   `json:"name"`
+		Origins []string `json:"origins"`
+	} `json:"pin"`
+}
+
+// newPinningService returns a minimal in-memory Pinning Service API handler.
+// Listings return at most pageSize results. Pins are created in pairs that share a timestamp.
+func newPinningService(t *testing.T, token string, pageSize int) http.Handler {
+	var (
+		pins []*pinStatus
+		next int
+		lk   sync.Mutex
+		base = time.Now()
+	)
+	decode := func(r *http.Request) *pinStatus {
+		var s pinStatus
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&s.Pin))
+		assert.NotEmpty(t, s.Pin.Origins)
+		next++
+		s.RequestID = fmt.Sprintf("%d", next)
+		s.Status = "pinned"
+		s.Created = base.Add(time.Duration(next/2) * time.Second)
+		return &s
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"reason":"UNAUTHORIZED"}}`))
+			return
+		}
+		lk.Lock()
+		defer lk.Unlock()
+		id := strings.TrimPrefix(r.URL.Path, "/pins/")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/pins":
+			q := r.URL.Query()
+			var before time.Time
+			if v := q.Get("before"); v != "" {
+				var err error
+				before, err = time.Parse(time.RFC3339Nano, v)
+				assert.NoError(t, err)
+			}
+			results := []*pinStatus{}
+			for i := len(pins) - 1; i >= 0; i-- {
+				if c := q.Get("cid"); c != "" && pins[i].Pin.Cid != c {
+					continue
+				}
+				if n := q.Get("name"); n != "" && pins[i].Pin.Name != n {
+					continue
+				}
+				if !before.IsZero() && !pins[i].Created.Before(before) {
+					continue
+				}
+				results = append(results, pins[i])
+			}
+			sort.SliceStable(results, func(i, j int) bool {
+				return results[i].Created.After(results[j].Created)
+			})
+			count := len(results)
+			limit := pageSize
+			if v := q.Get("limit"); v != "" {
+				l, err := strconv.Atoi(v)
+				assert.NoError(t, err)
+				if l < limit {
+					limit = l
+				}
+			}
+			if len(results) > limit {
+				results = results[:limit]
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"count":   count,
+				"results": results,
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/pins":
+			s := decode(r)
+			pins = append(pins, s)
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(s)
+		case r.Method == http.MethodPost:
+			for i, p := range pins {
+				if p.RequestID == id {
+					pins[i] = decode(r)
+					w.WriteHeader(http.StatusAccepted)
+					_ = json.NewEncoder(w).Encode(pins[i])
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodDelete:
+			for i, p := range pins {
+				if p.RequestID == id {
+					pins = append(pins[:i], pins[i+1:]...)
+					w.WriteHeader(http.StatusAccepted)
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+}
@@ -0,0 +1,145 @@
+package buckets
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/dcrypto"
+	"github.com/textileio/go-buckets/audit"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+// SetPrivacy converts a bucket between public and private in place.
+// Making a bucket private encrypts its dag with new link and file keys and removes the
+// default public reader role from the bucket root.
+// Making a bucket public decrypts its dag into plain UnixFS, drops all keys, and adds the
+// default public reader role to the bucket root.
+// Only the bucket owner can change privacy.
+//
+// Replaced roots can't be read with the new keys, so the previous root and all
+// pinned versions are unpinned once the new root is saved.
+func (b *Buckets) SetPrivacy(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	private bool,
+	root path.Resolved,
+	identity did.Token,
+) (int64, *Bucket, error) {
+	caller, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	lk := b.locks.Get(lock(key))
+	lk.Acquire()
+	defer lk.Release()
+
+	ctx, instance, err := b.getSafe(ctx, thread, key, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+	ctx = dag.NewOwnerContext(ctx, instance.Owner)
+	if root != nil && root.String() != instance.Path {
+		return 0, nil, ErrNonFastForward
+	}
+	if instance.IsPrivate() == private {
+		if private {
+			return 0, nil, fmt.Errorf("bucket is already private")
+		}
+		return 0, nil, fmt.Errorf("bucket is already public")
+	}
+	if instance.Version == 0 {
+		return 0, nil, fmt.Errorf("changing privacy is not supported by version 0 buckets")
+	}
+	md, _, ok := instance.GetMetadataForPath("", false)
+	if !ok {
+		return 0, nil, fmt.Errorf("permission denied")
+	}
+
+	replaced, err := pinnedRoots(instance)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	currentLinkKey := instance.GetLinkEncryptionKey()
+	var currentFileKeys, newFileKeys map[string][]byte
+	var newLinkKey, newFileKey []byte
+	roles := make(map[did.DID]collection.Role, len(md.Roles))
+	for k, r := range md.Roles {
+		roles[k] = r
+	}
+	if private {
+		newLinkKey, err = dcrypto.NewKey()
+		if err != nil {
+			return 0, nil, err
+		}
+		newFileKey, err = dcrypto.NewKey()
+		if err != nil {
+			return 0, nil, err
+		}
+		instance.LinkKey = base64.StdEncoding.EncodeToString(newLinkKey)
+		md.SetFileEncryptionKey(newFileKey)
+		if roles["*"] == collection.ReaderRole {
+			delete(roles, "*")
+		}
+	} else {
+		currentFileKeys, err = instance.GetFileEncryptionKeysForPrefix("")
+		if err != nil {
+			return 0, nil, err
+		}
+		instance.LinkKey = ""
+		for p, x := range instance.Metadata {
+			x.Key = ""
+			instance.Metadata[p] = x
+		}
+		md.Key = ""
+		if roles["*"] < collection.ReaderRole {
+			roles["*"] = collection.ReaderRole
+		}
+	}
+
+	instance.UpdatedAt = time.Now().UnixNano()
+	md.Roles = roles
+	md.UpdatedAt = instance.UpdatedAt
+	instance.Metadata[""] = md
+	if private {
+		newFileKeys, err = instance.GetFileEncryptionKeysForPrefix("")
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	if err := b.verify(ctx, thread, instance, identity); err != nil {
+		return 0, nil, err
+	}
+
+	ctx, pth, err := dag.CreateBucketPathFromRoot(
+		ctx,
+		b.ipfs,
+		b.pinner,
+		replaced[0],
+		currentLinkKey,
+		currentFileKeys,
+		newLinkKey,
+		newFileKeys,
+		newFileKey,
+		nil,
+	)
+	if err != nil {
+		return 0, nil, fmt.Errorf("converting bucket: %v", err)
+	}
+	ctx, err = b.replaceAllVersions(ctx, thread, instance, pth, replaced, currentLinkKey, identity, caller)
+	if err != nil {
+		return 0, nil, err
+	}
+	b.record(ctx, thread, key, caller, audit.OpSetPrivacy, nil, replaced[0].String(), instance.Path)
+
+	log.Debugf("set %s private to %t", key, private)
+	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, view(ctx, thread, instance)), nil
+}
@@ -7,20 +7,104 @@ import (
 
 	ipfsfiles "github.com/ipfs/go-ipfs-files"
 	"github.com/ipfs/interface-go-ipfs-core/path"
-	"github.com/textileio/dcrypto"
 	"github.com/textileio/go-buckets/dag"
 	"github.com/textileio/go-buckets/util"
 	"github.com/textileio/go-threads/core/did"
 	core "github.com/textileio/go-threads/core/thread"
 )
 
+// PathReader reads a file from a bucket.
+// Seek positions the reader anywhere in the file, removing any range limit.
+type PathReader interface {
+	io.ReadSeeker
+	io.Closer
+
+	// Size returns the total size of the file in bytes.
+	Size() int64
+}
+
 type pathReader struct {
-	r       io.Reader
-	closers []io.Closer
+	file     ipfsfiles.File
+	key      []byte
+	size     int64
+	encSize  int64
+	verified bool
+	pos      int64
+	limit    int64
+	r        io.Reader
+	closers  []io.Closer
 }
 
 func (r *pathReader) Read(p []byte) (int, error) {
-	return r.r.Read(p)
+	if r.r == nil {
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.r.Read(p)
+	r.pos += int64(n)
+	return n, err
+}
+
+// open prepares the underlying reader at the current position.
+// Encrypted files are verified the first time the reader is opened.
+// The file is content-addressed, so reopening it after a seek doesn't require verifying it again.
+func (r *pathReader) open() error {
+	if r.pos >= r.size {
+		r.r = eofReader{}
+		return nil
+	}
+	var rr io.Reader
+	if r.key == nil {
+		if _, err := r.file.Seek(r.pos, io.SeekStart); err != nil {
+			return err
+		}
+		rr = r.file
+	} else {
+		if !r.verified {
+			if err := dag.VerifyCiphertext(r.file, r.key, r.encSize); err != nil {
+				return err
+			}
+			r.verified = true
+		}
+		dr, err := dag.NewVerifiedRangeDecrypter(r.file, r.key, r.encSize, r.pos)
+		if err != nil {
+			return err
+		}
+		rr = dr
+	}
+	if r.limit > 0 {
+		rr = io.LimitReader(rr, r.limit)
+	}
+	r.r = rr
+	return nil
+}
+
+func (r *pathReader) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = r.pos + offset
+	case io.SeekEnd:
+		pos = r.size + offset
+	default:
+		return 0, fmt.Errorf("invalid whence")
+	}
+	if pos < 0 {
+		return 0, fmt.Errorf("negative position")
+	}
+	if pos != r.pos || r.limit > 0 {
+		r.pos = pos
+		r.limit = 0
+		r.r = nil // Reopen lazily on read
+	}
+	return pos, nil
+}
+
+func (r *pathReader) Size() int64 {
+	return r.size
 }
 
 func (r *pathReader) Close() error {
@@ -33,12 +117,29 @@ func (r *pathReader) Close() error {
 	return nil
 }
 
+type eofReader struct{}
+
+func (eofReader) Read([]byte) (int, error) {
+	return 0, io.EOF
+}
+
+// PullPath returns a reader of the file at path.
+// Use the WithRange option to read part of the file.
 func (b *Buckets) PullPath(
 	ctx context.Context,
 	thread core.ID,
 	key, pth string,
 	identity did.Token,
-) (io.ReadCloser, error) {
+	opts ...Option,
+) (PathReader, error) {
+	args := &Options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	if args.Offset < 0 || args.Length < 0 {
+		return nil, ErrInvalidRange
+	}
+
 	pth = trimSlash(pth)
 	instance, bpth, err := b.getBucketAndPath(ctx, thread, key, pth, identity)
 	if err != nil {
@@ -74,31 +175,60 @@ func (b *Buckets) PullPath(
 		}
 	}
 
-	r := &pathReader{}
-	node, err := b.ipfs.Unixfs().Get(ctx, filePath)
+	r, err := b.newPathReader(ctx, filePath, fileKey, args.Offset, args.Length)
 	if err != nil {
 		return nil, err
 	}
-	r.closers = append(r.closers, node)
 
+	log.Debugf("pulled %s from %s", pth, instance.Key)
+	return r, nil
+}
+
+// newPathReader returns a reader of the file at filePath, decrypting with fileKey if not nil.
+// The reader starts at offset and is limited to length bytes if length is greater than zero.
+func (b *Buckets) newPathReader(
+	ctx context.Context,
+	filePath path.Resolved,
+	fileKey []byte,
+	offset, length int64,
+) (*pathReader, error) {
+	node, err := b.ipfs.Unixfs().Get(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
 	file := ipfsfiles.ToFile(node)
 	if file == nil {
-		_ = r.Close()
+		_ = node.Close()
 		return nil, fmt.Errorf("node is a directory")
 	}
+	r := &pathReader{
+		file:    file,
+		key:     fileKey,
+		pos:     offset,
+		limit:   length,
+		closers: []io.Closer{node},
+	}
+	r.size, err = file.Size()
+	if err != nil {
+		_ = r.Close()
+		return nil, err
+	}
 	if fileKey != nil {
-		dr, err := dcrypto.NewDecrypter(file, fileKey)
+		r.encSize = r.size
+		r.size, err = dag.DecryptedSize(r.encSize)
 		if err != nil {
 			_ = r.Close()
 			return nil, err
 		}
-		r.closers = append(r.closers, dr)
-		r.r = dr
-	} else {
-		r.r = file
 	}
-
-	log.Debugf("pulled %s from %s", pth, instance.Key)
+	if offset > r.size {
+		_ = r.Close()
+		return nil, ErrInvalidRange
+	}
+	if err := r.open(); err != nil {
+		_ = r.Close()
+		return nil, err
+	}
 	return r, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -14,6 +15,7 @@ import (
 	ifaceopts "github.com/ipfs/interface-go-ipfs-core/options"
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/dcrypto"
+	"github.com/textileio/go-buckets/audit"
 	"github.com/textileio/go-buckets/collection"
 	"github.com/textileio/go-buckets/dag"
 	"github.com/textileio/go-buckets/util"
@@ -24,6 +26,9 @@ import (
 type PushPathsChunk struct {
 	Path string
 	Data []byte
+	// Offset is the position of Data in the file.
+	// Offset is only checked when using an upload session.
+	Offset int64
 }
 
 type PushPathsResult struct {
@@ -40,7 +45,14 @@ func (b *Buckets) PushPaths(
 	key string,
 	root path.Resolved,
 	identity did.Token,
+	opts ...PushPathsOption,
 ) (chan<- PushPathsChunk, <-chan PushPathsResult, <-chan error) {
+	args := &PushPathsOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	session := args.UploadSession
+
 	lk := b.locks.Get(lock(key))
 	lk.Acquire()
 
@@ -48,18 +60,34 @@ func (b *Buckets) PushPaths(
 	out := make(chan PushPathsResult)
 	errs := make(chan error, 1)
 
-	instance, err := b.c.GetSafe(ctx, thread, key, collection.WithIdentity(identity))
+	if session != "" {
+		if _, err := b.uploads.offset(thread, key, session, ""); err != nil {
+			errs <- err
+			lk.Release()
+			return in, out, errs
+		}
+	}
+	caller, err := b.ValidateIdentity(ctx, identity)
 	if err != nil {
 		errs <- err
 		lk.Release()
 		return in, out, errs
 	}
+
+	ctx, instance, err := b.getSafe(ctx, thread, key, identity)
+	if err != nil {
+		errs <- err
+		lk.Release()
+		return in, out, errs
+	}
+	ctx = dag.NewOwnerContext(ctx, instance.Owner)
 	if root != nil && root.String() != instance.Path {
 		errs <- ErrNonFastForward
 		lk.Release()
 		return in, out, errs
 	}
 	readOnlyInstance := instance.Copy()
+	oldRoot := instance.Path
 
 	ctx, cancel := context.WithCancel(ctx)
 	var wg sync.WaitGroup
@@ -67,10 +95,22 @@ func (b *Buckets) PushPaths(
 	addedCh := make(chan addedFile)
 	doneCh := make(chan struct{})
 	errCh := make(chan error)
+	stopCh := make(chan struct{})
+	interrupted := ctx.Done()
 	go func() {
 		queue := newFileQueue()
 		for {
 			select {
+			case <-interrupted:
+				// Request was interrupted, abort pending jobs
+				err := fmt.Errorf("push interrupted: %v", ctx.Err())
+				if n := queue.abort(err); n == 0 {
+					select {
+					case errCh <- err:
+					case <-stopCh:
+					}
+				}
+				return
 			case chunk, ok := <-in:
 				if !ok {
 					wg.Wait() // Request ended normally, wait for pending jobs
@@ -92,7 +132,7 @@ func (b *Buckets) PushPaths(
 						UpdatedAt: readOnlyInstance.UpdatedAt,
 					})
 					readOnlyInstance.UnsetMetadataWithPrefix(pth + "/")
-					if err := b.c.Verify(ctx, thread, readOnlyInstance, collection.WithIdentity(identity)); err != nil {
+					if err := b.verify(ctx, thread, readOnlyInstance, identity); err != nil {
 						return nil, fmt.Errorf("verifying bucket update: %v", err)
 					}
 					key, err := readOnlyInstance.GetFileEncryptionKeyForPath(pth)
@@ -106,28 +146,68 @@ func (b *Buckets) PushPaths(
 					return
 				}
 
+				if session != "" {
+					if !fa.resumed {
+						// Write data received by previous requests in the session
+						fa.resumed = true
+						if err := b.uploads.replay(ctx, thread, key, session, pth, fa.key, fa.writer); err != nil {
+							errCh <- fmt.Errorf("replaying upload session: %v", err)
+							return
+						}
+					}
+					offset, err := b.uploads.offset(thread, key, session, pth)
+					if err != nil {
+						errCh <- err
+						return
+					}
+					if chunk.Offset != offset {
+						errCh <- fmt.Errorf("invalid chunk offset for %s: expected %d, got %d", pth, offset, chunk.Offset)
+						return
+					}
+				}
+
 				if len(chunk.Data) > 0 {
 					if _, err := fa.writer.Write(chunk.Data); err != nil {
 						errCh <- fmt.Errorf("writing chunk: %v", err)
 						return
 					}
+					if session != "" {
+						if err := b.uploads.putChunk(ctx, thread, key, session, pth, chunk.Data, fa.key); err != nil {
+							errCh <- fmt.Errorf("recording chunk: %v", err)
+							return
+						}
+					}
 				} else {
 					if err := fa.writer.Close(); err != nil {
 						errCh <- fmt.Errorf("closing writer: %v", err)
 						return
 					}
+					fa.closed = true
 				}
 			}
 		}
 	}()
 
-	var changed bool
+	var (
+		changed bool
+		changes []change
+	)
+	completed := make(map[string]c.Cid)
 	sctx := util.NewClonedContext(ctx)
 	saveWithErr := func(err error) error {
 		cancel()
 		if !changed {
 			return err
 		}
+		var verr error
+		sctx, verr = b.addVersion(sctx, instance, caller, false)
+		if verr != nil {
+			if err != nil {
+				return err
+			}
+			return verr
+		}
+		b.changes.add(thread, instance, caller, oldRoot, changes...)
 		if serr := b.saveAndPublish(sctx, thread, instance, identity); serr != nil {
 			if err != nil {
 				return err
@@ -136,11 +216,30 @@ func (b *Buckets) PushPaths(
 		} else {
 			log.Debugf("saved bucket %s with path: %s", instance.Key, instance.Path)
 		}
+		ctxLock.RLock()
+		pushed := dag.GetPinnedBytes(ctx)
+		ctxLock.RUnlock()
+		paths := make([]string, 0, len(completed))
+		for p := range completed {
+			paths = append(paths, p)
+		}
+		sort.Strings(paths)
+		b.record(dag.AddPinnedBytes(sctx, pushed), thread, key, caller, audit.OpPushPaths, paths, oldRoot, instance.Path)
+		if session != "" {
+			saved, serr := util.NewResolvedPath(instance.Path)
+			if serr == nil {
+				serr = b.uploads.complete(sctx, thread, key, session, completed, saved)
+			}
+			if serr != nil {
+				log.Errorf("completing upload session %s: %v", session, serr)
+			}
+		}
 		return err
 	}
 
 	go func() {
 		defer lk.Release()
+		defer close(stopCh)
 		for {
 			select {
 			case res := <-addedCh:
@@ -148,43 +247,12 @@ func (b *Buckets) PushPaths(
 				ctx2 := ctx
 				ctxLock.RUnlock()
 
-				fn, err := b.ipfs.ResolveNode(ctx2, res.resolved)
+				ch := b.pathChange(ctx2, instance, res.path)
+				ctx2, dir, err := b.insertNodeAtPath(ctx2, instance, res.path, res.resolved)
 				if err != nil {
-					errs <- saveWithErr(fmt.Errorf("resolving added node: %v", err))
+					errs <- saveWithErr(err)
 					return
 				}
-
-				var dir path.Resolved
-				if instance.IsPrivate() {
-					ctx2, dir, err = dag.InsertNodeAtPath(
-						ctx2,
-						b.ipfs,
-						fn,
-						path.Join(path.New(instance.Path), res.path),
-						instance.GetLinkEncryptionKey(),
-					)
-					if err != nil {
-						errs <- saveWithErr(fmt.Errorf("inserting added node: %v", err))
-						return
-					}
-				} else {
-					dir, err = b.ipfs.Object().AddLink(
-						ctx2,
-						path.New(instance.Path),
-						res.path,
-						res.resolved,
-						ifaceopts.Object.Create(true),
-					)
-					if err != nil {
-						errs <- saveWithErr(fmt.Errorf("adding bucket link: %v", err))
-						return
-					}
-					ctx2, err = dag.UpdateOrAddPin(ctx2, b.ipfs, path.New(instance.Path), dir)
-					if err != nil {
-						errs <- saveWithErr(fmt.Errorf("updating bucket pin: %v", err))
-						return
-					}
-				}
 				instance.Path = dir.String()
 				instance.UpdatedAt = time.Now().UnixNano()
 				instance.SetMetadataAtPath(res.path, collection.Metadata{
@@ -197,7 +265,7 @@ func (b *Buckets) PushPaths(
 					Cid:    res.resolved.Cid(),
 					Size:   res.size,
 					Pinned: dag.GetPinnedBytes(ctx2),
-					Bucket: instanceToBucket(thread, instance),
+					Bucket: instanceToBucket(thread, view(ctx2, thread, instance)),
 				}
 
 				ctxLock.Lock()
@@ -205,6 +273,8 @@ func (b *Buckets) PushPaths(
 				ctxLock.Unlock()
 
 				log.Debugf("pushed %s to %s", res.path, instance.Key)
+				completed[res.path] = res.resolved.Cid()
+				changes = append(changes, ch)
 				changed = true // Save is needed
 				wg.Done()
 
@@ -221,9 +291,106 @@ func (b *Buckets) PushPaths(
 	return in, out, errs
 }
 
+// applyPushPath adds the data read from r at pth in the bucket instance without saving it.
+func (b *Buckets) applyPushPath(
+	ctx context.Context,
+	thread core.ID,
+	instance *collection.Bucket,
+	pth string,
+	r io.Reader,
+	identity did.Token,
+) (context.Context, error) {
+	pth, err := parsePath(pth)
+	if err != nil {
+		return ctx, err
+	}
+
+	instance.UpdatedAt = time.Now().UnixNano()
+	instance.SetMetadataAtPath(pth, collection.Metadata{
+		UpdatedAt: instance.UpdatedAt,
+	})
+	instance.UnsetMetadataWithPrefix(pth + "/")
+	if err := b.verify(ctx, thread, instance, identity); err != nil {
+		return ctx, fmt.Errorf("verifying bucket update: %v", err)
+	}
+	key, err := instance.GetFileEncryptionKeyForPath(pth)
+	if err != nil {
+		return ctx, fmt.Errorf("getting bucket key: %v", err)
+	}
+	if key != nil {
+		r, err = dcrypto.NewEncrypter(r, key)
+		if err != nil {
+			return ctx, fmt.Errorf("creating encrypter: %v", err)
+		}
+	}
+
+	res, err := b.ipfs.Unixfs().Add(
+		ctx,
+		ipfsfiles.NewReaderFile(r),
+		ifaceopts.Unixfs.CidVersion(1),
+		ifaceopts.Unixfs.Pin(false),
+	)
+	if err != nil {
+		return ctx, fmt.Errorf("adding file: %v", err)
+	}
+	ctx, dir, err := b.insertNodeAtPath(ctx, instance, pth, res)
+	if err != nil {
+		return ctx, err
+	}
+	instance.Path = dir.String()
+	return ctx, nil
+}
+
+// insertNodeAtPath links the added node at pth in the bucket instance,
+// returning the new bucket path.
+func (b *Buckets) insertNodeAtPath(
+	ctx context.Context,
+	instance *collection.Bucket,
+	pth string,
+	added path.Resolved,
+) (context.Context, path.Resolved, error) {
+	if instance.IsPrivate() {
+		fn, err := b.ipfs.ResolveNode(ctx, added)
+		if err != nil {
+			return ctx, nil, fmt.Errorf("resolving added node: %v", err)
+		}
+		ctx, dir, err := dag.InsertNodeAtPath(
+			ctx,
+			b.ipfs,
+			b.pinner,
+			fn,
+			path.Join(path.New(instance.Path), pth),
+			instance.GetLinkEncryptionKey(),
+		)
+		if err != nil {
+			return ctx, nil, fmt.Errorf("inserting added node: %v", err)
+		}
+		return ctx, dir, nil
+	}
+
+	dir, err := b.ipfs.Object().AddLink(
+		ctx,
+		path.New(instance.Path),
+		pth,
+		added,
+		ifaceopts.Object.Create(true),
+	)
+	if err != nil {
+		return ctx, nil, fmt.Errorf("adding bucket link: %v", err)
+	}
+	ctx, err = dag.UpdateOrAddPin(ctx, b.ipfs, b.pinner, path.New(instance.Path), dir)
+	if err != nil {
+		return ctx, nil, fmt.Errorf("updating bucket pin: %v", err)
+	}
+	return ctx, dir, nil
+}
+
 type fileAdder struct {
-	reader io.ReadCloser
-	writer io.WriteCloser
+	reader  io.ReadCloser
+	writer  *io.PipeWriter
+	key     []byte
+	resumed bool
+	closed  bool
 }
 
 type addedFile struct {
@@ -241,6 +408,20 @@ func newFileQueue() *fileQueue {
 	return &fileQueue{q: make(map[string]*fileAdder)}
 }
 
+// abort closes all open file writers with err, returning the number of writers closed.
+func (q *fileQueue) abort(err error) (n int) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for _, fa := range q.q {
+		if !fa.closed {
+			_ = fa.writer.CloseWithError(err)
+			fa.closed = true
+			n++
+		}
+	}
+	return n
+}
+
 func (q *fileQueue) add(
 	ctx context.Context,
 	ufs iface.UnixfsAPI,
@@ -266,6 +447,7 @@ func (q *fileQueue) add(
 	fa = &fileAdder{
 		reader: reader,
 		writer: writer,
+		key:    key,
 	}
 	q.q[pth] = fa
 
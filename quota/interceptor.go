@@ -0,0 +1,47 @@
+package quota
+
+import (
+	"context"
+
+	"github.com/textileio/go-buckets/dag"
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor returns a gRPC interceptor that enforces storage limits.
+// The ledger is injected into the request context as a dag.Accountant,
+// which charges storage pinned by the request to the owner of the affected bucket.
+func (l *Ledger) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		return handler(dag.NewAccountantContext(ctx, l), req)
+	}
+}
+
+// StreamServerInterceptor is the streaming version of UnaryServerInterceptor.
+func (l *Ledger) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &accountantStream{
+			ServerStream: stream,
+			ctx:          dag.NewAccountantContext(stream.Context(), l),
+		})
+	}
+}
+
+// accountantStream overrides the context of a server stream.
+type accountantStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *accountantStream) Context() context.Context {
+	return s.ctx
+}
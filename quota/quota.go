@@ -0,0 +1,210 @@
+package quota
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-threads/core/did"
+)
+
+var (
+	log = logging.Logger("buckets-quota")
+
+	dsPrefix = ds.NewKey("/quota")
+)
+
+// Unlimited can be used as a custom storage limit to remove the limit for an identity.
+const Unlimited int64 = -1
+
+// Config configures a Ledger.
+type Config struct {
+	// DefaultLimit is the storage limit in bytes for identities without a custom limit.
+	// Zero means no limit.
+	DefaultLimit int64
+}
+
+// Usage describes the storage used by an identity.
+type Usage struct {
+	// Identity is the DID of the storage owner.
+	Identity did.DID
+	// StorageUsed is the number of bytes pinned by the identity.
+	StorageUsed int64
+	// StorageLimit is a custom storage limit in bytes.
+	// Zero means the default limit applies. See Unlimited.
+	StorageLimit int64
+	// StorageAvailable is the number of bytes that can still be pinned by the identity,
+	// or Unlimited if the identity has no storage limit.
+	StorageAvailable int64
+	// UpdatedAt is the time of the last update.
+	UpdatedAt time.Time
+}
+
+// record is the persisted form of Usage.
+type record struct {
+	StorageUsed  int64
+	StorageLimit int64
+	UpdatedAt    time.Time
+}
+
+// Ledger is a persistent per-identity storage usage ledger.
+type Ledger struct {
+	store ds.TxnDatastore
+	conf  Config
+	lk    sync.Mutex
+}
+
+// NewLedger returns a new ledger backed by store.
+func NewLedger(store ds.TxnDatastore, conf Config) *Ledger {
+	return &Ledger{
+		store: store,
+		conf:  conf,
+	}
+}
+
+// Get returns usage for an identity.
+// Identities without any recorded usage have zero storage used.
+func (l *Ledger) Get(id did.DID) (*Usage, error) {
+	if id == "" {
+		return nil, errors.New("identity is required")
+	}
+	r, err := l.get(l.store, id)
+	if err != nil {
+		return nil, err
+	}
+	return l.usage(id, r), nil
+}
+
+// Add adds delta bytes to the storage used by an identity.
+// Storage used never drops below zero.
+func (l *Ledger) Add(id did.DID, delta int64) (*Usage, error) {
+	if id == "" {
+		return nil, errors.New("identity is required")
+	}
+	return l.update(id, func(r *record) error {
+		r.add(delta)
+		return nil
+	})
+}
+
+// Reserve atomically adds delta bytes to the storage used by an identity.
+// A positive delta that exceeds the identity's available storage is not applied and
+// returns dag.ErrStorageQuotaExhausted. A negative delta releases storage.
+// Reserve implements dag.Accountant.
+func (l *Ledger) Reserve(id did.DID, delta int64) error {
+	if id == "" {
+		return errors.New("identity is required")
+	}
+	_, err := l.update(id, func(r *record) error {
+		if delta > 0 {
+			if available := l.usage(id, r).StorageAvailable; available != Unlimited && delta > available {
+				return dag.ErrStorageQuotaExhausted
+			}
+		}
+		r.add(delta)
+		return nil
+	})
+	return err
+}
+
+// Set sets the storage used and custom storage limit for an identity.
+func (l *Ledger) Set(id did.DID, used, limit int64) (*Usage, error) {
+	if id == "" {
+		return nil, errors.New("identity is required")
+	}
+	if used < 0 {
+		return nil, errors.New("storage used must not be negative")
+	}
+	if limit < Unlimited {
+		return nil, errors.New("invalid storage limit")
+	}
+	return l.update(id, func(r *record) error {
+		r.StorageUsed = used
+		r.StorageLimit = limit
+		return nil
+	})
+}
+
+// add adds delta bytes to the storage used.
+// Storage used never drops below zero.
+func (r *record) add(delta int64) {
+	r.StorageUsed += delta
+	if r.StorageUsed < 0 {
+		r.StorageUsed = 0
+	}
+}
+
+// update applies fn to the record for an identity.
+// The record is not updated if fn returns an error.
+func (l *Ledger) update(id did.DID, fn func(r *record) error) (*Usage, error) {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	txn, err := l.store.NewTransaction(false)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard()
+
+	r, err := l.get(txn, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := fn(r); err != nil {
+		return nil, err
+	}
+	r.UpdatedAt = time.Now()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(r); err != nil {
+		return nil, err
+	}
+	if err := txn.Put(dsPrefix.ChildString(string(id)), buf.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+	return l.usage(id, r), nil
+}
+
+// get returns the record for an identity, or an empty record if none exists.
+func (l *Ledger) get(r ds.Read, id did.DID) (*record, error) {
+	val, err := r.Get(dsPrefix.ChildString(string(id)))
+	if errors.Is(err, ds.ErrNotFound) {
+		return &record{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("getting usage: %v", err)
+	}
+	var rec record
+	if err := gob.NewDecoder(bytes.NewReader(val)).Decode(&rec); err != nil {
+		return nil, fmt.Errorf("decoding usage: %v", err)
+	}
+	return &rec, nil
+}
+
+// usage returns Usage for a record.
+func (l *Ledger) usage(id did.DID, r *record) *Usage {
+	u := &Usage{
+		Identity:     id,
+		StorageUsed:  r.StorageUsed,
+		StorageLimit: r.StorageLimit,
+		UpdatedAt:    r.UpdatedAt,
+	}
+	limit := r.StorageLimit
+	if limit == 0 {
+		limit = l.conf.DefaultLimit
+	}
+	if limit <= 0 {
+		u.StorageAvailable = Unlimited
+	} else if r.StorageUsed < limit {
+		u.StorageAvailable = limit - r.StorageUsed
+	}
+	return u
+}
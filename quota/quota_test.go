@@ -0,0 +1,130 @@
+package quota_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-buckets/dag"
+	. "github.com/textileio/go-buckets/quota"
+	"github.com/textileio/go-threads/core/did"
+	"github.com/textileio/go-threads/db"
+)
+
+func TestLedger_Get(t *testing.T) {
+	ds := db.NewTxMapDatastore()
+	defer ds.Close()
+	l := NewLedger(ds, Config{DefaultLimit: 100})
+
+	usage, err := l.Get(did.DID("did:key:foo"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), usage.StorageUsed)
+	assert.Equal(t, int64(100), usage.StorageAvailable)
+
+	_, err = l.Get("")
+	require.Error(t, err)
+}
+
+func TestLedger_Add(t *testing.T) {
+	ds := db.NewTxMapDatastore()
+	defer ds.Close()
+	l := NewLedger(ds, Config{DefaultLimit: 100})
+	id := did.DID("did:key:foo")
+
+	usage, err := l.Add(id, 60)
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), usage.StorageUsed)
+	assert.Equal(t, int64(40), usage.StorageAvailable)
+
+	usage, err = l.Add(id, 60)
+	require.NoError(t, err)
+	assert.Equal(t, int64(120), usage.StorageUsed)
+	assert.Equal(t, int64(0), usage.StorageAvailable)
+
+	usage, err = l.Add(id, -200)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), usage.StorageUsed)
+
+	// Usage is persisted
+	l = NewLedger(ds, Config{DefaultLimit: 100})
+	_, err = l.Add(id, 10)
+	require.NoError(t, err)
+	usage, err = l.Get(id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), usage.StorageUsed)
+}
+
+func TestLedger_Set(t *testing.T) {
+	ds := db.NewTxMapDatastore()
+	defer ds.Close()
+	l := NewLedger(ds, Config{DefaultLimit: 100})
+	id := did.DID("did:key:foo")
+
+	usage, err := l.Set(id, 50, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, int64(50), usage.StorageUsed)
+	assert.Equal(t, int64(1000), usage.StorageLimit)
+	assert.Equal(t, int64(950), usage.StorageAvailable)
+
+	usage, err = l.Set(id, 50, Unlimited)
+	require.NoError(t, err)
+	assert.Equal(t, Unlimited, usage.StorageAvailable)
+
+	usage, err = l.Set(id, 50, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(50), usage.StorageAvailable)
+
+	_, err = l.Set(id, -1, 0)
+	require.Error(t, err)
+	_, err = l.Set(id, 0, -2)
+	require.Error(t, err)
+}
+
+func TestLedger_Reserve(t *testing.T) {
+	ds := db.NewTxMapDatastore()
+	defer ds.Close()
+	l := NewLedger(ds, Config{DefaultLimit: 100})
+	id := did.DID("did:key:foo")
+
+	err := l.Reserve(id, 60)
+	require.NoError(t, err)
+	err = l.Reserve(id, 60)
+	require.ErrorIs(t, err, dag.ErrStorageQuotaExhausted)
+	usage, err := l.Get(id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), usage.StorageUsed)
+
+	err = l.Reserve(id, -20)
+	require.NoError(t, err)
+	usage, err = l.Get(id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(40), usage.StorageUsed)
+
+	// Concurrent reservations can't exceed the limit
+	var wg sync.WaitGroup
+	var reserved int64
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.Reserve(id, 10); err == nil {
+				atomic.AddInt64(&reserved, 10)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(60), reserved)
+	usage, err = l.Get(id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), usage.StorageUsed)
+
+	_, err = l.Set(id, 0, Unlimited)
+	require.NoError(t, err)
+	err = l.Reserve(id, 1000)
+	require.NoError(t, err)
+
+	err = l.Reserve("", 10)
+	require.Error(t, err)
+}
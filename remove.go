@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets/audit"
 	"github.com/textileio/go-buckets/collection"
 	"github.com/textileio/go-buckets/dag"
 	"github.com/textileio/go-threads/core/did"
@@ -18,40 +19,67 @@ func (b *Buckets) RemovePath(
 	root path.Resolved,
 	identity did.Token,
 ) (int64, *Bucket, error) {
-	lk := b.locks.Get(lock(key))
-	lk.Acquire()
-	defer lk.Release()
-
-	pth, err := parsePath(pth)
+	caller, err := b.ValidateIdentity(ctx, identity)
 	if err != nil {
 		return 0, nil, err
 	}
 
-	instance, err := b.c.GetSafe(ctx, thread, key, collection.WithIdentity(identity))
+	lk := b.locks.Get(lock(key))
+	lk.Acquire()
+	defer lk.Release()
+
+	ctx, instance, err := b.getSafe(ctx, thread, key, identity)
 	if err != nil {
 		return 0, nil, err
 	}
+	ctx = dag.NewOwnerContext(ctx, instance.Owner)
 
 	if root != nil && root.String() != instance.Path {
 		return 0, nil, ErrNonFastForward
 	}
 
-	instance.UpdatedAt = time.Now().UnixNano()
-	instance.UnsetMetadataWithPrefix(pth)
-	if err := b.c.Verify(ctx, thread, instance, collection.WithIdentity(identity)); err != nil {
+	oldRoot := instance.Path
+	ctx, err = b.applyRemovePath(ctx, thread, instance, pth, identity)
+	if err != nil {
 		return 0, nil, err
 	}
-
-	ctx, dirPath, err := b.removePath(ctx, instance, pth)
+	ctx, err = b.addVersion(ctx, instance, caller, false)
 	if err != nil {
 		return 0, nil, err
 	}
-
-	instance.Path = dirPath.String()
+	b.changes.add(thread, instance, caller, oldRoot, change{typ: EventPathRemoved, path: trimSlash(pth)})
 	if err := b.saveAndPublish(ctx, thread, instance, identity); err != nil {
 		return 0, nil, err
 	}
+	b.record(ctx, thread, key, caller, audit.OpRemovePath, []string{pth}, oldRoot, instance.Path)
 
 	log.Debugf("removed %s from %s", pth, key)
-	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, instance), nil
+	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, view(ctx, thread, instance)), nil
+}
+
+// applyRemovePath removes pth from the bucket instance without saving it.
+func (b *Buckets) applyRemovePath(
+	ctx context.Context,
+	thread core.ID,
+	instance *collection.Bucket,
+	pth string,
+	identity did.Token,
+) (context.Context, error) {
+	pth, err := parsePath(pth)
+	if err != nil {
+		return ctx, err
+	}
+
+	instance.UpdatedAt = time.Now().UnixNano()
+	instance.UnsetMetadataWithPrefix(pth)
+	if err := b.verify(ctx, thread, instance, identity); err != nil {
+		return ctx, err
+	}
+
+	ctx, dirPath, err := b.removePath(ctx, instance, pth)
+	if err != nil {
+		return ctx, err
+	}
+	instance.Path = dirPath.String()
+	return ctx, nil
 }
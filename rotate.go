@@ -0,0 +1,181 @@
+package buckets
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/dcrypto"
+	"github.com/textileio/go-buckets/audit"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-buckets/util"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+// ErrNotPrivate indicates an operation that requires a private bucket was called on a public bucket.
+var ErrNotPrivate = errors.New("bucket is not private")
+
+// RotateKeys re-encrypts a private bucket's directory structure with a new link key.
+// Use WithRotateFileKeys to also replace every file encryption key.
+// Only the bucket owner can rotate keys.
+//
+// Replaced roots can't be decrypted with the new link key, so the previous root and all
+// pinned versions are unpinned once the new root is saved.
+func (b *Buckets) RotateKeys(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	identity did.Token,
+	opts ...RotateKeysOption,
+) (int64, *Bucket, error) {
+	args := &RotateKeysOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+
+	caller, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	lk := b.locks.Get(lock(key))
+	lk.Acquire()
+	defer lk.Release()
+
+	ctx, instance, err := b.getSafe(ctx, thread, key, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+	ctx = dag.NewOwnerContext(ctx, instance.Owner)
+	if args.Root != nil && args.Root.String() != instance.Path {
+		return 0, nil, ErrNonFastForward
+	}
+	if !instance.IsPrivate() {
+		return 0, nil, ErrNotPrivate
+	}
+	if instance.Version == 0 {
+		return 0, nil, fmt.Errorf("rotating keys is not supported by version 0 buckets")
+	}
+	if _, _, ok := instance.GetMetadataForPath("", false); !ok {
+		return 0, nil, fmt.Errorf("permission denied")
+	}
+
+	replaced, err := pinnedRoots(instance)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	currentLinkKey := instance.GetLinkEncryptionKey()
+	currentFileKeys, err := instance.GetFileEncryptionKeysForPrefix("")
+	if err != nil {
+		return 0, nil, err
+	}
+	newLinkKey, err := dcrypto.NewKey()
+	if err != nil {
+		return 0, nil, err
+	}
+	if args.FileKeys {
+		if err := instance.RotateFileEncryptionKeysForPrefix(""); err != nil {
+			return 0, nil, err
+		}
+	}
+	newFileKeys, err := instance.GetFileEncryptionKeysForPrefix("")
+	if err != nil {
+		return 0, nil, err
+	}
+	newFileKey, err := instance.GetFileEncryptionKeyForPath("")
+	if err != nil {
+		return 0, nil, err
+	}
+
+	instance.LinkKey = base64.StdEncoding.EncodeToString(newLinkKey)
+	instance.UpdatedAt = time.Now().UnixNano()
+	instance.SetMetadataAtPath("", collection.Metadata{
+		UpdatedAt: instance.UpdatedAt,
+	})
+	if err := b.verify(ctx, thread, instance, identity); err != nil {
+		return 0, nil, err
+	}
+
+	ctx, pth, err := dag.CreateBucketPathFromRoot(
+		ctx,
+		b.ipfs,
+		b.pinner,
+		replaced[0],
+		currentLinkKey,
+		currentFileKeys,
+		newLinkKey,
+		newFileKeys,
+		newFileKey,
+		nil,
+	)
+	if err != nil {
+		return 0, nil, fmt.Errorf("re-encrypting bucket: %v", err)
+	}
+	ctx, err = b.replaceAllVersions(ctx, thread, instance, pth, replaced, currentLinkKey, identity, caller)
+	if err != nil {
+		return 0, nil, err
+	}
+	b.record(ctx, thread, key, caller, audit.OpRotateKeys, nil, replaced[0].String(), instance.Path)
+
+	log.Debugf("rotated keys for %s", key)
+	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, view(ctx, thread, instance)), nil
+}
+
+// pinnedRoots returns the current root of instance followed by its pinned versions.
+func pinnedRoots(instance *collection.Bucket) ([]path.Resolved, error) {
+	current, err := util.NewResolvedPath(instance.Path)
+	if err != nil {
+		return nil, fmt.Errorf("resolving path: %v", err)
+	}
+	roots := []path.Resolved{current}
+	for _, r := range instance.History {
+		if !r.Pinned || r.Path == instance.Path {
+			continue
+		}
+		p, err := util.NewResolvedPath(r.Path)
+		if err != nil {
+			return nil, fmt.Errorf("resolving path: %v", err)
+		}
+		roots = append(roots, p)
+	}
+	return roots, nil
+}
+
+// replaceAllVersions saves and publishes pth as the new root of instance on behalf of caller.
+// All versions are marked as unrestorable, and the replaced roots,
+// which are walked with linkKey, are unpinned once the new root is saved.
+func (b *Buckets) replaceAllVersions(
+	ctx context.Context,
+	thread core.ID,
+	instance *collection.Bucket,
+	pth path.Resolved,
+	replaced []path.Resolved,
+	linkKey []byte,
+	identity did.Token,
+	caller did.DID,
+) (context.Context, error) {
+	instance.Path = pth.String()
+	for i := range instance.History {
+		instance.History[i].Pinned = false
+		instance.History[i].Keys = nil
+	}
+	ctx, err := b.addVersion(ctx, instance, caller, true)
+	if err != nil {
+		return ctx, err
+	}
+	b.changes.add(thread, instance, caller, replaced[0].String(), change{typ: EventRootReplaced})
+	if err := b.saveAndPublish(ctx, thread, instance, identity); err != nil {
+		return ctx, err
+	}
+	ctx, err = dag.UnpinNodesAndBranches(ctx, b.ipfs, b.pinner, replaced, nil, linkKey)
+	if err != nil {
+		return ctx, fmt.Errorf("unpinning replaced roots: %v", err)
+	}
+	return ctx, nil
+}
@@ -0,0 +1,164 @@
+package buckets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	gopath "path"
+	"time"
+
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+var (
+	// SearchDefaultLimit is the number of search results returned when no limit is given.
+	SearchDefaultLimit = 100
+	// SearchMaxLimit is the maximum number of search results returned in a single page.
+	SearchMaxLimit = 1000
+)
+
+// SearchItemType restricts search results to files or folders.
+type SearchItemType int
+
+const (
+	// SearchAny matches files and folders.
+	SearchAny SearchItemType = iota
+	// SearchFiles only matches files.
+	SearchFiles
+	// SearchDirs only matches folders.
+	SearchDirs
+)
+
+// SearchQuery describes the items to find in a bucket.
+// Zero-value fields are ignored.
+type SearchQuery struct {
+	// Path is the folder to search under. The bucket root is searched by default.
+	Path string
+	// Name is a glob pattern matched against item names, e.g., "*.jpg".
+	Name string
+	// Type restricts results to files or folders.
+	Type SearchItemType
+	// MinSize is the minimum item size in bytes.
+	MinSize int64
+	// MaxSize is the maximum item size in bytes.
+	MaxSize int64
+	// UpdatedAfter matches items with metadata updated after the given time.
+	UpdatedAfter time.Time
+	// UpdatedBefore matches items with metadata updated before the given time.
+	UpdatedBefore time.Time
+	// Attributes must all be present in item metadata attributes.
+	// An empty value matches any value.
+	Attributes map[string]string
+	// Limit is the maximum number of results. See SearchDefaultLimit and SearchMaxLimit.
+	Limit int
+	// Cursor is the cursor returned with the previous page of results.
+	Cursor string
+}
+
+// match returns whether or not item matches the query.
+func (q SearchQuery) match(item *PathItem) (bool, error) {
+	if q.Type == SearchFiles && item.IsDir || q.Type == SearchDirs && !item.IsDir {
+		return false, nil
+	}
+	if q.Name != "" {
+		ok, err := gopath.Match(q.Name, item.Name)
+		if err != nil {
+			return false, fmt.Errorf("invalid name pattern: %v", err)
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	if q.MinSize > 0 && item.Size < q.MinSize || q.MaxSize > 0 && item.Size > q.MaxSize {
+		return false, nil
+	}
+	updated := time.Unix(0, item.Metadata.UpdatedAt)
+	if !q.UpdatedAfter.IsZero() && !updated.After(q.UpdatedAfter) ||
+		!q.UpdatedBefore.IsZero() && !updated.Before(q.UpdatedBefore) {
+		return false, nil
+	}
+	for k, v := range q.Attributes {
+		x, ok := item.Metadata.Attributes[k]
+		if !ok || v != "" && x != v {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Search walks the bucket depth-first and returns items that match query.
+// Folders are walked in name order, so results are stable across pages.
+// The returned cursor can be used to fetch the next page of results.
+// An empty cursor indicates there are no more results.
+func (b *Buckets) Search(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	query SearchQuery,
+	identity did.Token,
+) ([]PathItem, string, error) {
+	if query.Limit <= 0 {
+		query.Limit = SearchDefaultLimit
+	} else if query.Limit > SearchMaxLimit {
+		query.Limit = SearchMaxLimit
+	}
+	if _, err := gopath.Match(query.Name, ""); err != nil {
+		return nil, "", fmt.Errorf("invalid name pattern: %v", err)
+	}
+	pth, err := parsePath(query.Path)
+	if err != nil {
+		return nil, "", err
+	}
+	query.Cursor = trimSlash(query.Cursor)
+
+	instance, bpth, err := b.getBucketAndPath(ctx, thread, key, pth, identity)
+	if err != nil {
+		return nil, "", err
+	}
+	linkKey := instance.GetLinkEncryptionKey()
+	node, err := dag.GetNodeAtPath(ctx, b.ipfs, bpth, linkKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not resolve path: %s", pth)
+	}
+
+	w := &walker{
+		b:        b,
+		instance: instance,
+		key:      linkKey,
+		cursor:   query.Cursor,
+		depth:    ListDepthUnlimited,
+	}
+	var (
+		items   []PathItem
+		cursors []string
+	)
+	if err := w.walk(ctx, node, bpth.String(), pth, 0, func(rel string, item *PathItem, depth int, after bool) error {
+		if depth == 0 || !after {
+			return nil
+		}
+		ok, err := query.match(item)
+		if err != nil {
+			return err
+		}
+		if ok {
+			items = append(items, *item)
+			cursors = append(cursors, rel)
+			if len(items) > query.Limit {
+				return errWalkDone
+			}
+		}
+		return nil
+	}); err != nil && !errors.Is(err, errWalkDone) {
+		return nil, "", err
+	}
+	var next string
+	if len(items) > query.Limit {
+		items = items[:query.Limit]
+		next = cursors[query.Limit-1]
+	}
+
+	log.Debugf("searched %s in %s", pth, key)
+	return items, next, nil
+}
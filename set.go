@@ -6,6 +6,7 @@ import (
 
 	c "github.com/ipfs/go-cid"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets/audit"
 	"github.com/textileio/go-buckets/collection"
 	"github.com/textileio/go-buckets/dag"
 	"github.com/textileio/go-threads/core/did"
@@ -17,17 +18,56 @@ func (b *Buckets) SetPath(
 	thread core.ID,
 	key, pth string,
 	cid c.Cid,
+	root path.Resolved,
 	identity did.Token,
 ) (int64, *Bucket, error) {
+	caller, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+
 	lck := b.locks.Get(lock(key))
 	lck.Acquire()
 	defer lck.Release()
 
-	instance, err := b.c.GetSafe(ctx, thread, key, collection.WithIdentity(identity))
+	ctx, instance, err := b.getSafe(ctx, thread, key, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+	ctx = dag.NewOwnerContext(ctx, instance.Owner)
+	if root != nil && root.String() != instance.Path {
+		return 0, nil, ErrNonFastForward
+	}
+
+	oldRoot := instance.Path
+	ch := b.pathChange(ctx, instance, trimSlash(pth))
+	ctx, err = b.applySetPath(ctx, thread, instance, pth, cid, identity)
 	if err != nil {
 		return 0, nil, err
 	}
+	ctx, err = b.addVersion(ctx, instance, caller, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	b.changes.add(thread, instance, caller, oldRoot, ch)
+	if err := b.save(ctx, thread, instance, identity); err != nil {
+		return 0, nil, err
+	}
+	b.record(ctx, thread, key, caller, audit.OpSetPath, []string{pth}, oldRoot, instance.Path)
 
+	log.Debugf("set %s to %s", pth, cid)
+	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, view(ctx, thread, instance)), nil
+}
+
+// applySetPath sets pth to cid in the bucket instance without saving it.
+func (b *Buckets) applySetPath(
+	ctx context.Context,
+	thread core.ID,
+	instance *collection.Bucket,
+	pth string,
+	cid c.Cid,
+	identity did.Token,
+) (context.Context, error) {
 	pth = trimSlash(pth)
 	instance.UpdatedAt = time.Now().UnixNano()
 	instance.SetMetadataAtPath(pth, collection.Metadata{
@@ -35,8 +75,8 @@ func (b *Buckets) SetPath(
 	})
 	instance.UnsetMetadataWithPrefix(pth + "/")
 
-	if err := b.c.Verify(ctx, thread, instance, collection.WithIdentity(identity)); err != nil {
-		return 0, nil, err
+	if err := b.verify(ctx, thread, instance, identity); err != nil {
+		return ctx, err
 	}
 
 	var linkKey, fileKey []byte
@@ -45,20 +85,15 @@ func (b *Buckets) SetPath(
 		var err error
 		fileKey, err = instance.GetFileEncryptionKeyForPath(pth)
 		if err != nil {
-			return 0, nil, err
+			return ctx, err
 		}
 	}
 
 	buckPath := path.New(instance.Path)
 	ctx, dirPath, err := b.setPathFromExistingCid(ctx, instance, buckPath, pth, cid, linkKey, fileKey)
 	if err != nil {
-		return 0, nil, err
+		return ctx, err
 	}
 	instance.Path = dirPath.String()
-	if err := b.c.Save(ctx, thread, instance, collection.WithIdentity(identity)); err != nil {
-		return 0, nil, err
-	}
-
-	log.Debugf("set %s to %s", pth, cid)
-	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, instance), nil
+	return ctx, nil
 }
@@ -0,0 +1,29 @@
+package share
+
+import "time"
+
+// DefaultTTL is the default lifetime of a share link.
+const DefaultTTL = time.Hour * 24
+
+type Options struct {
+	TTL          time.Duration
+	MaxDownloads int
+}
+
+type Option func(*Options)
+
+// WithTTL sets how long a share link is valid for.
+// Defaults to DefaultTTL.
+func WithTTL(ttl time.Duration) Option {
+	return func(args *Options) {
+		args.TTL = ttl
+	}
+}
+
+// WithMaxDownloads limits the number of file downloads allowed with a share link.
+// By default, downloads are not limited.
+func WithMaxDownloads(max int) Option {
+	return func(args *Options) {
+		args.MaxDownloads = max
+	}
+}
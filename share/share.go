@@ -0,0 +1,318 @@
+package share
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	gopath "path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/util"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+var (
+	log = logging.Logger("buckets-share")
+
+	dsPrefix = ds.NewKey("/share")
+
+	// ErrNotFound indicates a share link does not exist or was revoked.
+	ErrNotFound = errors.New("share link not found")
+
+	// ErrExpired indicates a share link has expired.
+	ErrExpired = errors.New("share link has expired")
+
+	// ErrDownloadLimit indicates a share link has no downloads left.
+	ErrDownloadLimit = errors.New("share link download limit reached")
+
+	// ErrPathNotShared indicates a path is outside of a share link's path.
+	ErrPathNotShared = errors.New("path is not shared")
+)
+
+// idLen is the number of random bytes in a share link ID.
+const idLen = 32
+
+// Link is a read-only capability for a single bucket path.
+// The ID is the only secret needed to use a link.
+// Links don't hold any of the issuer's credentials, only the path and role the issuer granted,
+// so bucket reads made with a link are limited to the grant and stop working if the issuer loses access.
+type Link struct {
+	// ID is the secret share token.
+	ID string
+	// Thread is the thread ID of the shared bucket.
+	Thread core.ID
+	// Key is the key of the shared bucket.
+	Key string
+	// Path is the shared path. Descendants of a shared folder are also shared.
+	Path string
+	// Issuer is the DID of the identity that created the link.
+	Issuer did.DID
+	// Role is the role granted to link holders at Path.
+	Role collection.Role
+	// ExpiresAt is the time after which the link is no longer valid.
+	ExpiresAt time.Time
+	// MaxDownloads is the maximum number of file downloads allowed. Zero means no limit.
+	MaxDownloads int
+	// Downloads is the number of file downloads so far.
+	// A file download is counted each time the full size of the file has been served,
+	// regardless of how the file was split into range requests.
+	Downloads int
+	// Served is the number of bytes served toward the next download of each file path.
+	Served map[string]int64
+	// CreatedAt is the time the link was created.
+	CreatedAt time.Time
+}
+
+// Expired returns whether or not the link has expired at t.
+func (l *Link) Expired(t time.Time) bool {
+	return !t.Before(l.ExpiresAt)
+}
+
+// CanDownload returns whether or not the link has downloads left.
+func (l *Link) CanDownload() bool {
+	return l.MaxDownloads == 0 || l.Downloads < l.MaxDownloads
+}
+
+// Contains returns whether or not pth is covered by the link.
+func (l *Link) Contains(pth string) bool {
+	pth = cleanPath(pth)
+	return l.Path == "" || pth == l.Path || strings.HasPrefix(pth, l.Path+"/")
+}
+
+// Store is a persistent store of share links.
+type Store struct {
+	store ds.TxnDatastore
+	lk    sync.Mutex
+}
+
+// NewStore returns a new share link store backed by store.
+func NewStore(store ds.TxnDatastore) *Store {
+	return &Store{store: store}
+}
+
+// Create issues a new share link granting role at a bucket path.
+// The link ID is generated.
+// The link expires after ttl.
+func (s *Store) Create(
+	thread core.ID,
+	key, pth string,
+	issuer did.DID,
+	role collection.Role,
+	ttl time.Duration,
+	maxDownloads int,
+) (*Link, error) {
+	if issuer == "" {
+		return nil, errors.New("identity is required")
+	}
+	if role == collection.NoneRole {
+		return nil, errors.New("role is required")
+	}
+	if ttl <= 0 {
+		return nil, errors.New("expiration must be in the future")
+	}
+	if maxDownloads < 0 {
+		return nil, errors.New("download limit must not be negative")
+	}
+	now := time.Now()
+	l := &Link{
+		ID:           util.MakeToken(idLen),
+		Thread:       thread,
+		Key:          key,
+		Path:         cleanPath(pth),
+		Issuer:       issuer,
+		Role:         role,
+		ExpiresAt:    now.Add(ttl),
+		MaxDownloads: maxDownloads,
+		CreatedAt:    now,
+	}
+
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	if err := s.put(s.store, l); err != nil {
+		return nil, err
+	}
+	log.Debugf("created share link for %s/%s in %s", key, l.Path, thread)
+	return l, nil
+}
+
+// Get returns a share link by ID.
+func (s *Store) Get(id string) (*Link, error) {
+	return s.get(s.store, id)
+}
+
+// List returns all share links for a bucket issued by issuer, oldest first.
+// Expired links are included.
+func (s *Store) List(thread core.ID, key string, issuer did.DID) ([]*Link, error) {
+	res, err := s.store.Query(query.Query{Prefix: dsPrefix.String()})
+	if err != nil {
+		return nil, fmt.Errorf("querying share links: %v", err)
+	}
+	defer res.Close()
+	var links []*Link
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("querying share links: %v", r.Error)
+		}
+		l, err := decode(r.Value)
+		if err != nil {
+			return nil, err
+		}
+		if l.Thread == thread && l.Key == key && l.Issuer == issuer {
+			links = append(links, l)
+		}
+	}
+	sort.Slice(links, func(i, j int) bool {
+		return links[i].CreatedAt.Before(links[j].CreatedAt)
+	})
+	return links, nil
+}
+
+// Revoke deletes a share link.
+// Only the issuer can revoke a link.
+func (s *Store) Revoke(id string, issuer did.DID) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	l, err := s.get(s.store, id)
+	if err != nil {
+		return err
+	}
+	if l.Issuer != issuer {
+		return ErrNotFound
+	}
+	if err := s.store.Delete(dsPrefix.ChildString(id)); err != nil {
+		return fmt.Errorf("deleting share link: %v", err)
+	}
+	log.Debugf("revoked share link for %s/%s in %s", l.Key, l.Path, l.Thread)
+	return nil
+}
+
+// Validate returns the share link for id if it's valid for pth.
+// If download is true, a download is counted against the link's download limit.
+// This is meant for downloads that are always served in full, like archives.
+// File downloads that may be split into range requests should be counted with AddServed.
+func (s *Store) Validate(id, pth string, download bool) (*Link, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	txn, err := s.store.NewTransaction(false)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard()
+
+	l, err := s.get(txn, id)
+	if err != nil {
+		return nil, err
+	}
+	if l.Expired(time.Now()) {
+		return nil, ErrExpired
+	}
+	if !l.Contains(pth) {
+		return nil, ErrPathNotShared
+	}
+	if !download {
+		return l, nil
+	}
+	if !l.CanDownload() {
+		return nil, ErrDownloadLimit
+	}
+	l.Downloads++
+	if err := s.put(txn, l); err != nil {
+		return nil, err
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// AddServed records that n bytes of the file at pth, which is size bytes, were served with the link.
+// A download is counted against the link's download limit each time the bytes served
+// for pth add up to size, so downloads can't be split into range requests to avoid the limit.
+func (s *Store) AddServed(id, pth string, n, size int64) (*Link, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	txn, err := s.store.NewTransaction(false)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard()
+
+	l, err := s.get(txn, id)
+	if err != nil {
+		return nil, err
+	}
+	pth = cleanPath(pth)
+	if l.Served == nil {
+		l.Served = make(map[string]int64)
+	}
+	served := l.Served[pth] + n
+	if size <= 0 {
+		l.Downloads++
+		served = 0
+	}
+	for size > 0 && served >= size {
+		l.Downloads++
+		served -= size
+	}
+	if served > 0 {
+		l.Served[pth] = served
+	} else {
+		delete(l.Served, pth)
+	}
+	if err := s.put(txn, l); err != nil {
+		return nil, err
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (s *Store) put(w ds.Write, l *Link) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(l); err != nil {
+		return err
+	}
+	if err := w.Put(dsPrefix.ChildString(l.ID), buf.Bytes()); err != nil {
+		return fmt.Errorf("putting share link: %v", err)
+	}
+	return nil
+}
+
+func (s *Store) get(r ds.Read, id string) (*Link, error) {
+	if id == "" {
+		return nil, ErrNotFound
+	}
+	val, err := r.Get(dsPrefix.ChildString(id))
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("getting share link: %v", err)
+	}
+	return decode(val)
+}
+
+func decode(val []byte) (*Link, error) {
+	var l Link
+	if err := gob.NewDecoder(bytes.NewReader(val)).Decode(&l); err != nil {
+		return nil, fmt.Errorf("decoding share link: %v", err)
+	}
+	return &l, nil
+}
+
+// cleanPath returns pth relative to the bucket root.
+func cleanPath(pth string) string {
+	pth = gopath.Clean("/" + pth)
+	return strings.TrimPrefix(pth, "/")
+}
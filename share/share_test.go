@@ -0,0 +1,157 @@
+package share_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-buckets/collection"
+	. "github.com/textileio/go-buckets/share"
+	"github.com/textileio/go-threads/core/did"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/go-threads/db"
+)
+
+var (
+	issuer = did.DID("did:key:foo")
+	role   = collection.ReaderRole
+)
+
+func TestStore_Create(t *testing.T) {
+	ds := db.NewTxMapDatastore()
+	defer ds.Close()
+	s := NewStore(ds)
+	id := thread.NewRandomIDV1()
+
+	l, err := s.Create(id, "key", "/one/two/", issuer, role, time.Hour, 2)
+	require.NoError(t, err)
+	assert.NotEmpty(t, l.ID)
+	assert.Equal(t, "one/two", l.Path)
+	assert.False(t, l.Expired(time.Now()))
+	assert.True(t, l.Expired(time.Now().Add(2*time.Hour)))
+
+	got, err := s.Get(l.ID)
+	require.NoError(t, err)
+	assert.Equal(t, l.ID, got.ID)
+	assert.Equal(t, issuer, got.Issuer)
+	assert.Equal(t, role, got.Role)
+
+	_, err = s.Create(id, "key", "", "", role, time.Hour, 0)
+	require.Error(t, err)
+	_, err = s.Create(id, "key", "", issuer, role, 0, 0)
+	require.Error(t, err)
+	_, err = s.Create(id, "key", "", issuer, role, time.Hour, -1)
+	require.Error(t, err)
+	_, err = s.Create(id, "key", "", issuer, collection.NoneRole, time.Hour, 0)
+	require.Error(t, err)
+}
+
+func TestStore_List(t *testing.T) {
+	ds := db.NewTxMapDatastore()
+	defer ds.Close()
+	s := NewStore(ds)
+	id := thread.NewRandomIDV1()
+
+	l1, err := s.Create(id, "key", "one", issuer, role, time.Hour, 0)
+	require.NoError(t, err)
+	l2, err := s.Create(id, "key", "two", issuer, role, time.Hour, 0)
+	require.NoError(t, err)
+	_, err = s.Create(id, "other", "one", issuer, role, time.Hour, 0)
+	require.NoError(t, err)
+	_, err = s.Create(id, "key", "one", "did:key:bar", role, time.Hour, 0)
+	require.NoError(t, err)
+
+	links, err := s.List(id, "key", issuer)
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+	assert.Equal(t, l1.ID, links[0].ID)
+	assert.Equal(t, l2.ID, links[1].ID)
+}
+
+func TestStore_Revoke(t *testing.T) {
+	ds := db.NewTxMapDatastore()
+	defer ds.Close()
+	s := NewStore(ds)
+
+	l, err := s.Create(thread.NewRandomIDV1(), "key", "one", issuer, role, time.Hour, 0)
+	require.NoError(t, err)
+
+	err = s.Revoke(l.ID, "did:key:bar")
+	require.ErrorIs(t, err, ErrNotFound)
+	err = s.Revoke(l.ID, issuer)
+	require.NoError(t, err)
+	_, err = s.Get(l.ID)
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = s.Validate(l.ID, "one", false)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestStore_Validate(t *testing.T) {
+	ds := db.NewTxMapDatastore()
+	defer ds.Close()
+	s := NewStore(ds)
+
+	l, err := s.Create(thread.NewRandomIDV1(), "key", "one", issuer, role, time.Hour, 2)
+	require.NoError(t, err)
+
+	_, err = s.Validate(l.ID, "one/file.txt", false)
+	require.NoError(t, err)
+	_, err = s.Validate(l.ID, "two", false)
+	require.ErrorIs(t, err, ErrPathNotShared)
+	_, err = s.Validate(l.ID, "one2", false)
+	require.ErrorIs(t, err, ErrPathNotShared)
+	_, err = s.Validate(l.ID, "one/../two", false)
+	require.ErrorIs(t, err, ErrPathNotShared)
+
+	// Downloads are counted against the limit
+	for i := 0; i < 2; i++ {
+		got, err := s.Validate(l.ID, "one/file.txt", true)
+		require.NoError(t, err)
+		assert.Equal(t, i+1, got.Downloads)
+	}
+	_, err = s.Validate(l.ID, "one/file.txt", true)
+	require.ErrorIs(t, err, ErrDownloadLimit)
+	_, err = s.Validate(l.ID, "one", false)
+	require.NoError(t, err)
+
+	// Expired links are rejected
+	l, err = s.Create(thread.NewRandomIDV1(), "key", "", issuer, role, time.Millisecond, 0)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 5)
+	_, err = s.Validate(l.ID, "one", false)
+	require.ErrorIs(t, err, ErrExpired)
+}
+
+func TestStore_AddServed(t *testing.T) {
+	ds := db.NewTxMapDatastore()
+	defer ds.Close()
+	s := NewStore(ds)
+
+	l, err := s.Create(thread.NewRandomIDV1(), "key", "", issuer, role, time.Hour, 2)
+	require.NoError(t, err)
+
+	// Ranges are counted by bytes served, wherever they start
+	got, err := s.AddServed(l.ID, "file.txt", 4, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, got.Downloads)
+	got, err = s.AddServed(l.ID, "/file.txt", 6, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, got.Downloads)
+	assert.Empty(t, got.Served)
+	assert.True(t, got.CanDownload())
+
+	// Bytes are counted per path
+	got, err = s.AddServed(l.ID, "other.txt", 5, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, got.Downloads)
+	got, err = s.AddServed(l.ID, "file.txt", 15, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.Downloads)
+	assert.Equal(t, int64(5), got.Served["file.txt"])
+	assert.Equal(t, int64(5), got.Served["other.txt"])
+	assert.False(t, got.CanDownload())
+
+	_, err = s.Validate(l.ID, "file.txt", true)
+	require.ErrorIs(t, err, ErrDownloadLimit)
+}
@@ -0,0 +1,497 @@
+package buckets
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	c "github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	ipld "github.com/ipfs/go-ipld-format"
+	mdag "github.com/ipfs/go-merkledag"
+	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/dcrypto"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-buckets/pinning"
+	"github.com/textileio/go-buckets/util"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+var (
+	// UploadSessionTTL is the duration after which an inactive upload session is discarded.
+	UploadSessionTTL = time.Hour * 24
+
+	uploadsPrefix = ds.NewKey("/uploads")
+
+	// ErrUploadSessionNotFound indicates that an upload session does not exist or has expired.
+	ErrUploadSessionNotFound = errors.New("upload session not found")
+)
+
+// UploadSession tracks the data received by PushPaths so that an interrupted upload can be resumed.
+type UploadSession struct {
+	// ID is the unique session ID.
+	ID string
+	// Thread is the bucket thread ID.
+	Thread core.ID
+	// Key is the bucket key.
+	Key string
+	// Root is the bucket root after the last saved file, or nil if no file has been saved.
+	Root path.Resolved
+	// Files maps bucket paths to received file data.
+	Files map[string]UploadFile
+	// UpdatedAt is the time of the last session update.
+	UpdatedAt time.Time
+
+	// owner is the bucket owner, who is charged for received chunks.
+	owner did.DID
+}
+
+// UploadFile describes the portion of a file received in an upload session.
+type UploadFile struct {
+	// Chunks are the content-addressed chunks received, in order.
+	Chunks []c.Cid
+	// Size is the number of bytes received.
+	Size int64
+	// Complete indicates that the file has been saved to the bucket.
+	Complete bool
+	// Cid is the resulting file cid when complete.
+	Cid c.Cid
+}
+
+// uploadSessionReapInterval is the interval at which expired upload sessions are removed.
+const uploadSessionReapInterval = time.Minute * 10
+
+// uploadSessions is a persistent upload session store.
+// Received chunks are pinned until their file is saved or the session expires.
+type uploadSessions struct {
+	ipfs   iface.CoreAPI
+	pinner pinning.Pinner
+	store  ds.Datastore
+	lk     sync.Mutex
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func newUploadSessions(ipfs iface.CoreAPI, pinner pinning.Pinner, store ds.Datastore) *uploadSessions {
+	u := &uploadSessions{
+		ipfs:   ipfs,
+		pinner: pinner,
+		store:  store,
+		closed: make(chan struct{}),
+	}
+	go u.reap()
+	return u
+}
+
+// reap removes expired sessions at an interval until the store is closed.
+func (u *uploadSessions) reap() {
+	ticker := time.NewTicker(uploadSessionReapInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			u.expire(context.Background())
+		case <-u.closed:
+			return
+		}
+	}
+}
+
+// close stops reaping expired sessions.
+func (u *uploadSessions) close() {
+	u.closeOnce.Do(func() {
+		close(u.closed)
+	})
+}
+
+// NewUploadSession starts a new upload session for a bucket.
+// Pass the session ID to PushPaths with WithUploadSession to record received data.
+func (b *Buckets) NewUploadSession(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	identity did.Token,
+) (*UploadSession, error) {
+	_, instance, err := b.getSafe(ctx, thread, key, identity)
+	if err != nil {
+		return nil, err
+	}
+	s := &UploadSession{
+		ID:        util.MakeToken(32),
+		Thread:    thread,
+		Key:       key,
+		Files:     make(map[string]UploadFile),
+		UpdatedAt: time.Now(),
+		owner:     instance.Owner,
+	}
+	b.uploads.lk.Lock()
+	err = b.uploads.put(s)
+	b.uploads.lk.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("started upload session %s for %s", s.ID, key)
+	return s, nil
+}
+
+// GetUploadSession returns an upload session for a bucket.
+// Use the session to determine which files and bytes need to be sent when resuming PushPaths.
+func (b *Buckets) GetUploadSession(
+	ctx context.Context,
+	thread core.ID,
+	key, id string,
+	identity did.Token,
+) (*UploadSession, error) {
+	if _, _, err := b.getSafe(ctx, thread, key, identity); err != nil {
+		return nil, err
+	}
+	// Wait for an interrupted push to finish saving
+	lk := b.locks.Get(lock(key))
+	lk.Acquire()
+	lk.Release()
+
+	b.uploads.lk.Lock()
+	defer b.uploads.lk.Unlock()
+	return b.uploads.get(thread, key, id)
+}
+
+// storedSession is the persisted form of an upload session.
+type storedSession struct {
+	ID        string
+	Thread    core.ID
+	Key       string
+	Root      string
+	Files     map[string]storedFile
+	UpdatedAt time.Time
+	Owner     did.DID
+}
+
+// storedFile is the persisted form of an upload file.
+type storedFile struct {
+	Chunks   []string
+	Size     int64
+	Complete bool
+	Cid      string
+}
+
+// get returns a session by ID.
+// The caller must hold the lock.
+func (u *uploadSessions) get(thread core.ID, key, id string) (*UploadSession, error) {
+	if id == "" {
+		return nil, ErrUploadSessionNotFound
+	}
+	val, err := u.store.Get(uploadsPrefix.ChildString(id))
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil, ErrUploadSessionNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("getting upload session: %v", err)
+	}
+	s, err := decodeUploadSession(val)
+	if err != nil {
+		return nil, err
+	}
+	// Expired sessions are removed by the reaper
+	if s.Thread != thread || s.Key != key || time.Since(s.UpdatedAt) > UploadSessionTTL {
+		return nil, ErrUploadSessionNotFound
+	}
+	return s, nil
+}
+
+// put persists a session.
+// The caller must hold the lock.
+func (u *uploadSessions) put(s *UploadSession) error {
+	ss := storedSession{
+		ID:        s.ID,
+		Thread:    s.Thread,
+		Key:       s.Key,
+		Files:     make(map[string]storedFile, len(s.Files)),
+		UpdatedAt: s.UpdatedAt,
+		Owner:     s.owner,
+	}
+	if s.Root != nil {
+		ss.Root = s.Root.String()
+	}
+	for p, f := range s.Files {
+		sf := storedFile{
+			Chunks:   make([]string, len(f.Chunks)),
+			Size:     f.Size,
+			Complete: f.Complete,
+		}
+		for i, ch := range f.Chunks {
+			sf.Chunks[i] = ch.String()
+		}
+		if f.Cid.Defined() {
+			sf.Cid = f.Cid.String()
+		}
+		ss.Files[p] = sf
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(ss); err != nil {
+		return fmt.Errorf("encoding upload session: %v", err)
+	}
+	if err := u.store.Put(uploadsPrefix.ChildString(s.ID), buf.Bytes()); err != nil {
+		return fmt.Errorf("putting upload session: %v", err)
+	}
+	return nil
+}
+
+func decodeUploadSession(val []byte) (*UploadSession, error) {
+	var ss storedSession
+	if err := gob.NewDecoder(bytes.NewReader(val)).Decode(&ss); err != nil {
+		return nil, fmt.Errorf("decoding upload session: %v", err)
+	}
+	s := &UploadSession{
+		ID:        ss.ID,
+		Thread:    ss.Thread,
+		Key:       ss.Key,
+		Files:     make(map[string]UploadFile, len(ss.Files)),
+		UpdatedAt: ss.UpdatedAt,
+		owner:     ss.Owner,
+	}
+	if ss.Root != "" {
+		r, err := util.NewResolvedPath(ss.Root)
+		if err != nil {
+			return nil, fmt.Errorf("decoding upload session root: %v", err)
+		}
+		s.Root = r
+	}
+	for p, sf := range ss.Files {
+		f := UploadFile{
+			Chunks:   make([]c.Cid, len(sf.Chunks)),
+			Size:     sf.Size,
+			Complete: sf.Complete,
+		}
+		for i, ch := range sf.Chunks {
+			cc, err := c.Decode(ch)
+			if err != nil {
+				return nil, fmt.Errorf("decoding upload chunk: %v", err)
+			}
+			f.Chunks[i] = cc
+		}
+		if sf.Cid != "" {
+			cc, err := c.Decode(sf.Cid)
+			if err != nil {
+				return nil, fmt.Errorf("decoding upload file cid: %v", err)
+			}
+			f.Cid = cc
+		}
+		s.Files[p] = f
+	}
+	return s, nil
+}
+
+// offset returns the number of bytes received for a path.
+func (u *uploadSessions) offset(thread core.ID, key, id, pth string) (int64, error) {
+	u.lk.Lock()
+	defer u.lk.Unlock()
+	s, err := u.get(thread, key, id)
+	if err != nil {
+		return 0, err
+	}
+	return s.Files[pth].Size, nil
+}
+
+// putChunk stores a chunk of data for a path.
+// Data is encrypted with key before it is stored, if key is not nil.
+// The chunk is pinned and charged to the session's bucket owner until it's released.
+// Chunk storage is not included in the pinned bytes for ctx.
+func (u *uploadSessions) putChunk(
+	ctx context.Context,
+	thread core.ID,
+	key, id, pth string,
+	data, fileKey []byte,
+) error {
+	u.lk.Lock()
+	s, err := u.get(thread, key, id)
+	if err != nil {
+		u.lk.Unlock()
+		return err
+	}
+	owner := s.owner
+	u.lk.Unlock()
+
+	size := int64(len(data))
+	if fileKey != nil {
+		r, err := dcrypto.NewEncrypter(bytes.NewReader(data), fileKey)
+		if err != nil {
+			return fmt.Errorf("creating encrypter: %v", err)
+		}
+		data, err = ioutil.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("encrypting chunk: %v", err)
+		}
+	}
+	n := mdag.NewRawNode(data)
+	if _, err := dag.AddAndPinNodes(dag.NewOwnerContext(ctx, owner), u.ipfs, u.pinner, []ipld.Node{n}); err != nil {
+		return fmt.Errorf("pinning chunk: %v", err)
+	}
+
+	u.lk.Lock()
+	s, err = u.get(thread, key, id)
+	if err != nil {
+		u.lk.Unlock()
+		u.unpin(ctx, owner, []c.Cid{n.Cid()}) // The session expired while pinning
+		return err
+	}
+	f := s.Files[pth]
+	f.Chunks = append(f.Chunks, n.Cid())
+	f.Size += size
+	f.Complete = false
+	s.Files[pth] = f
+	s.UpdatedAt = time.Now()
+	err = u.put(s)
+	u.lk.Unlock()
+	if err != nil {
+		u.unpin(ctx, owner, []c.Cid{n.Cid()})
+		return err
+	}
+	return nil
+}
+
+// replay writes the stored chunks for a path to w.
+// Chunks are decrypted with key, if key is not nil.
+func (u *uploadSessions) replay(
+	ctx context.Context,
+	thread core.ID,
+	key, id, pth string,
+	fileKey []byte,
+	w io.Writer,
+) error {
+	u.lk.Lock()
+	s, err := u.get(thread, key, id)
+	if err != nil {
+		u.lk.Unlock()
+		return err
+	}
+	chunks := s.Files[pth].Chunks
+	u.lk.Unlock()
+
+	for _, ch := range chunks {
+		if err := u.replayChunk(ctx, ch, fileKey, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *uploadSessions) replayChunk(ctx context.Context, ch c.Cid, fileKey []byte, w io.Writer) error {
+	r, err := u.ipfs.Block().Get(ctx, path.IpfsPath(ch))
+	if err != nil {
+		return fmt.Errorf("getting chunk: %v", err)
+	}
+	if fileKey != nil {
+		dr, err := dcrypto.NewDecrypter(r, fileKey)
+		if err != nil {
+			return fmt.Errorf("creating decrypter: %v", err)
+		}
+		defer dr.Close()
+		r = dr
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		return fmt.Errorf("writing chunk: %v", err)
+	}
+	return nil
+}
+
+// complete marks files as saved to the bucket at root and releases their chunks.
+func (u *uploadSessions) complete(
+	ctx context.Context,
+	thread core.ID,
+	key, id string,
+	files map[string]c.Cid,
+	root path.Resolved,
+) error {
+	u.lk.Lock()
+	s, err := u.get(thread, key, id)
+	if err != nil {
+		u.lk.Unlock()
+		return err
+	}
+	owner := s.owner
+	var release []c.Cid
+	for p, fc := range files {
+		f := s.Files[p]
+		release = append(release, f.Chunks...)
+		f.Chunks = nil
+		f.Complete = true
+		f.Cid = fc
+		s.Files[p] = f
+	}
+	s.Root = root
+	s.UpdatedAt = time.Now()
+	err = u.put(s)
+	u.lk.Unlock()
+	if err != nil {
+		return err
+	}
+
+	u.unpin(ctx, owner, release)
+	return nil
+}
+
+// expire removes sessions that have been inactive longer than UploadSessionTTL,
+// unpinning their received chunks.
+func (u *uploadSessions) expire(ctx context.Context) {
+	u.lk.Lock()
+	release := make(map[did.DID][]c.Cid)
+	res, err := u.store.Query(query.Query{Prefix: uploadsPrefix.String()})
+	if err != nil {
+		u.lk.Unlock()
+		log.Errorf("querying upload sessions: %v", err)
+		return
+	}
+	var expired []ds.Key
+	for r := range res.Next() {
+		if r.Error != nil {
+			log.Errorf("querying upload sessions: %v", r.Error)
+			break
+		}
+		s, err := decodeUploadSession(r.Value)
+		if err != nil {
+			log.Errorf("expiring upload session: %v", err)
+			continue
+		}
+		if time.Since(s.UpdatedAt) > UploadSessionTTL {
+			for _, f := range s.Files {
+				release[s.owner] = append(release[s.owner], f.Chunks...)
+			}
+			expired = append(expired, ds.NewKey(r.Key))
+		}
+	}
+	res.Close()
+	for _, k := range expired {
+		if err := u.store.Delete(k); err != nil {
+			log.Errorf("deleting upload session %s: %v", k.BaseNamespace(), err)
+			continue
+		}
+		log.Debugf("expired upload session %s", k.BaseNamespace())
+	}
+	u.lk.Unlock()
+
+	for owner, chunks := range release {
+		u.unpin(ctx, owner, chunks)
+	}
+}
+
+// unpin removes chunk pins, allowing them to be garbage collected.
+// The released storage is returned to owner.
+func (u *uploadSessions) unpin(ctx context.Context, owner did.DID, chunks []c.Cid) {
+	ctx = dag.NewOwnerContext(ctx, owner)
+	for _, ch := range chunks {
+		var err error
+		if ctx, err = dag.UnpinPath(ctx, u.ipfs, u.pinner, path.IpfsPath(ch)); err != nil {
+			log.Debugf("unpinning upload chunk %s: %v", ch, err)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package buckets_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	httpapi "github.com/ipfs/go-ipfs-http-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-buckets"
+	"github.com/textileio/go-buckets/api/apitest"
+	"github.com/textileio/go-buckets/pinning"
+	tdb "github.com/textileio/go-threads/db"
+)
+
+func TestBuckets_UploadSessionStore(t *testing.T) {
+	ctx := context.Background()
+	ipfs, err := httpapi.NewApi(apitest.GetIPFSApiMultiAddr())
+	require.NoError(t, err)
+	pinner := pinning.NewIPFSPinner(ipfs, tdb.NewTxMapDatastore())
+	store := tdb.NewTxMapDatastore()
+	lib := newLib(t, pinner, buckets.WithUploadSessionStore(store))
+	identity := newIdentity(t, lib)
+
+	buck, _, _, err := lib.Create(ctx, identity)
+	require.NoError(t, err)
+	session, err := lib.NewUploadSession(ctx, buck.Thread, buck.Key, identity)
+	require.NoError(t, err)
+
+	// Sessions survive a restart
+	lib = newLib(t, pinner, buckets.WithUploadSessionStore(store))
+	got, err := lib.GetUploadSession(ctx, buck.Thread, buck.Key, session.ID, identity)
+	require.NoError(t, err)
+	assert.Equal(t, session.ID, got.ID)
+	assert.Equal(t, session.UpdatedAt.UnixNano(), got.UpdatedAt.UnixNano())
+
+	// Expired sessions can't be resumed
+	ttl := buckets.UploadSessionTTL
+	buckets.UploadSessionTTL = time.Millisecond
+	t.Cleanup(func() { buckets.UploadSessionTTL = ttl })
+	time.Sleep(time.Millisecond * 10)
+	_, err = lib.GetUploadSession(ctx, buck.Thread, buck.Key, session.ID, identity)
+	assert.ErrorIs(t, err, buckets.ErrUploadSessionNotFound)
+}
@@ -0,0 +1,247 @@
+package buckets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	gopath "path"
+	"time"
+
+	c "github.com/ipfs/go-cid"
+	ipfsfiles "github.com/ipfs/go-ipfs-files"
+	ipld "github.com/ipfs/go-ipld-format"
+	mdag "github.com/ipfs/go-merkledag"
+	"github.com/ipfs/go-unixfs"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/dcrypto"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-buckets/util"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+// VerifyFetchTimeout limits the time spent fetching a single block while verifying a bucket.
+var VerifyFetchTimeout = time.Minute
+
+// VerifyItem describes a verified bucket file or folder.
+type VerifyItem struct {
+	Path string `json:"path"`
+	// Cid is the item cid as listed by ListPath, i.e., after decrypting private folders.
+	Cid   c.Cid `json:"cid"`
+	IsDir bool  `json:"is_dir"`
+}
+
+// VerifyError describes a bucket item or block that failed verification.
+type VerifyError struct {
+	Path   string `json:"path"`
+	Cid    c.Cid  `json:"cid"`
+	Reason string `json:"reason"`
+}
+
+// VerifyReport describes the result of verifying a bucket.
+type VerifyReport struct {
+	// Root is the verified bucket root.
+	Root c.Cid `json:"root"`
+	// Blocks is the number of blocks that were retrieved and hash-checked.
+	Blocks int `json:"blocks"`
+	// Items lists every file and folder that was reached.
+	Items []VerifyItem `json:"items"`
+	// Errors lists missing, corrupted, or undecryptable items.
+	Errors []VerifyError `json:"errors"`
+	// Skipped lists private files whose decryption could not be checked
+	// because their keys are not readable by the identity.
+	Skipped []string `json:"skipped"`
+}
+
+// OK returns whether or not the bucket passed verification.
+func (r *VerifyReport) OK() bool {
+	return len(r.Errors) == 0
+}
+
+// Verify walks the bucket dag, confirming that every block is retrievable and hashes to its cid.
+// Private bucket folders must decrypt with the bucket link key,
+// and private files must decrypt with their path's file key.
+// Verification failures are listed in the report. A non-nil error is only returned
+// if the bucket could not be verified at all.
+func (b *Buckets) Verify(ctx context.Context, thread core.ID, key string, identity did.Token) (*VerifyReport, error) {
+	instance, err := b.getView(ctx, thread, key, identity)
+	if err != nil {
+		return nil, err
+	}
+	root, err := util.NewResolvedPath(instance.Path)
+	if err != nil {
+		return nil, fmt.Errorf("resolving path: %v", err)
+	}
+	v := &verifier{
+		b:        b,
+		instance: instance,
+		linkKey:  instance.GetLinkEncryptionKey(),
+		report:   &VerifyReport{Root: root.Cid()},
+		seen:     make(map[c.Cid]struct{}),
+	}
+	if v.linkKey != nil {
+		v.fileKeys, err = instance.GetFileEncryptionKeysForPrefix("")
+		if err != nil {
+			return nil, fmt.Errorf("getting file keys: %v", err)
+		}
+	}
+	if err := v.verifyItem(ctx, root.Cid(), ""); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("verified %s: %d blocks, %d errors", key, v.report.Blocks, len(v.report.Errors))
+	return v.report, nil
+}
+
+// verifier verifies the items and blocks of a single bucket.
+type verifier struct {
+	b        *Buckets
+	instance *collection.Bucket
+	linkKey  []byte
+	fileKeys map[string][]byte
+	report   *VerifyReport
+	// seen tracks file blocks that have already been verified.
+	seen map[c.Cid]struct{}
+}
+
+// verifyItem verifies the file or folder with cid id at pth.
+// Only context errors are returned, all other failures are added to the report.
+func (v *verifier) verifyItem(ctx context.Context, id c.Cid, pth string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	n, err := v.getNode(ctx, id)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		v.fail(pth, id, err)
+		return nil
+	}
+	icid := id
+	if v.linkKey != nil {
+		dn, decrypted, err := dag.DecryptNode(n, v.linkKey)
+		if err != nil {
+			v.fail(pth, id, fmt.Errorf("decrypting node with link key: %v", err))
+			return nil
+		}
+		if decrypted {
+			n, icid = dn, dn.Cid()
+		}
+	}
+
+	isDir := isDirNode(n)
+	v.report.Items = append(v.report.Items, VerifyItem{Path: pth, Cid: icid, IsDir: isDir})
+	if isDir {
+		for _, l := range n.Links() {
+			if err := v.verifyItem(ctx, l.Cid, gopath.Join(pth, l.Name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := v.verifyBlocks(ctx, n, pth); err != nil {
+		return err
+	}
+	if v.linkKey == nil {
+		return nil
+	}
+	fileKey, err := v.fileKey(pth)
+	if err != nil {
+		v.report.Skipped = append(v.report.Skipped, pth)
+		return nil
+	}
+	if fileKey == nil {
+		return nil
+	}
+	if err := v.decryptFile(ctx, id, fileKey); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		v.fail(pth, id, fmt.Errorf("decrypting file with file key: %v", err))
+	}
+	return nil
+}
+
+// verifyBlocks verifies all blocks linked to file node n.
+func (v *verifier) verifyBlocks(ctx context.Context, n ipld.Node, pth string) error {
+	for _, l := range n.Links() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, ok := v.seen[l.Cid]; ok {
+			continue
+		}
+		v.seen[l.Cid] = struct{}{}
+		cn, err := v.getNode(ctx, l.Cid)
+		if err != nil {
+			v.fail(pth, l.Cid, err)
+			continue
+		}
+		if err := v.verifyBlocks(ctx, cn, pth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getNode fetches and hash-checks the block with cid id.
+func (v *verifier) getNode(ctx context.Context, id c.Cid) (ipld.Node, error) {
+	ctx, cancel := context.WithTimeout(ctx, VerifyFetchTimeout)
+	defer cancel()
+	n, err := dag.GetVerifiedNode(ctx, v.b.ipfs, id)
+	if err != nil {
+		return nil, err
+	}
+	v.report.Blocks++
+	return n, nil
+}
+
+// fileKey returns the file encryption key for pth.
+// Paths without their own key use the key of the nearest parent.
+func (v *verifier) fileKey(pth string) ([]byte, error) {
+	if k, ok := v.fileKeys[pth]; ok && k != nil {
+		return k, nil
+	}
+	return v.instance.GetFileEncryptionKeyForPath(pth)
+}
+
+// decryptFile reads the entire file with cid id through a decrypter.
+func (v *verifier) decryptFile(ctx context.Context, id c.Cid, key []byte) error {
+	node, err := v.b.ipfs.Unixfs().Get(ctx, path.IpfsPath(id))
+	if err != nil {
+		return err
+	}
+	defer node.Close()
+	file := ipfsfiles.ToFile(node)
+	if file == nil {
+		return fmt.Errorf("node is a directory")
+	}
+	r, err := dcrypto.NewDecrypter(file, key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	_, err = io.Copy(ioutil.Discard, r)
+	return err
+}
+
+func (v *verifier) fail(pth string, id c.Cid, err error) {
+	v.report.Errors = append(v.report.Errors, VerifyError{
+		Path:   pth,
+		Cid:    id,
+		Reason: err.Error(),
+	})
+}
+
+func isDirNode(n ipld.Node) bool {
+	pn, ok := n.(*mdag.ProtoNode)
+	if !ok {
+		return false
+	}
+	fn, err := unixfs.FSNodeFromBytes(pn.Data())
+	return err == nil && fn.IsDir()
+}
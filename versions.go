@@ -0,0 +1,228 @@
+package buckets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-buckets/audit"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/dag"
+	"github.com/textileio/go-buckets/util"
+	"github.com/textileio/go-threads/core/did"
+	core "github.com/textileio/go-threads/core/thread"
+)
+
+// ListVersions returns the bucket's root history, most recent first.
+// The first item is the current root.
+func (b *Buckets) ListVersions(
+	ctx context.Context,
+	thread core.ID,
+	key string,
+	identity did.Token,
+) ([]collection.Root, error) {
+	instance, err := b.getView(ctx, thread, key, identity)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]collection.Root, len(instance.History))
+	for i, r := range instance.History {
+		r.Keys = nil
+		versions[len(versions)-1-i] = r
+	}
+
+	log.Debugf("listed versions for %s", key)
+	return versions, nil
+}
+
+// RestoreVersion sets the bucket root to a pinned root from the bucket's history.
+// version can be a root path or cid.
+// The replaced root is added to history like any other update.
+func (b *Buckets) RestoreVersion(
+	ctx context.Context,
+	thread core.ID,
+	key, version string,
+	root path.Resolved,
+	identity did.Token,
+) (int64, *Bucket, error) {
+	caller, err := b.ValidateIdentity(ctx, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	lk := b.locks.Get(lock(key))
+	lk.Acquire()
+	defer lk.Release()
+
+	ctx, instance, err := b.getSafe(ctx, thread, key, identity)
+	if err != nil {
+		return 0, nil, err
+	}
+	ctx = dag.NewOwnerContext(ctx, instance.Owner)
+	if root != nil && root.String() != instance.Path {
+		return 0, nil, ErrNonFastForward
+	}
+
+	target, ok := instance.GetRoot(version)
+	if !ok {
+		return 0, nil, fmt.Errorf("version not found: %s", version)
+	}
+	if target.Path == instance.Path {
+		return 0, nil, fmt.Errorf("version is the current root")
+	}
+	if !target.Pinned {
+		return 0, nil, fmt.Errorf("version is no longer pinned: %s", version)
+	}
+
+	instance.UpdatedAt = time.Now().UnixNano()
+	if instance.IsPrivate() {
+		instance.SetFileEncryptionKeys(target.Keys)
+	}
+	instance.SetMetadataAtPath("", collection.Metadata{
+		UpdatedAt: instance.UpdatedAt,
+	})
+	if err := b.verify(ctx, thread, instance, identity); err != nil {
+		return 0, nil, err
+	}
+
+	oldRoot := instance.Path
+	instance.Path = target.Path
+	ctx, err = b.addVersion(ctx, instance, caller, true)
+	if err != nil {
+		return 0, nil, err
+	}
+	b.changes.add(thread, instance, caller, oldRoot, change{typ: EventRootReplaced})
+	if err := b.saveAndPublish(ctx, thread, instance, identity); err != nil {
+		return 0, nil, err
+	}
+	b.record(ctx, thread, key, caller, audit.OpRestoreVersion, nil, oldRoot, instance.Path)
+
+	log.Debugf("restored %s to %s", key, target.Path)
+	return dag.GetPinnedBytes(ctx), instanceToBucket(thread, view(ctx, thread, instance)), nil
+}
+
+// retireVersions drops the history keys that were rotated at prefix.
+// Versions that used a rotated key are marked as unrestorable and unpinned, except for the
+// replaced root, which shares its unchanged branches with the current root.
+func (b *Buckets) retireVersions(ctx context.Context, instance *collection.Bucket, prefix string) (context.Context, error) {
+	current, err := util.NewResolvedPath(instance.Path)
+	if err != nil {
+		return ctx, fmt.Errorf("resolving path: %v", err)
+	}
+	var unpin []path.Resolved
+	for i := range instance.History {
+		r := &instance.History[i]
+		if !r.Pinned || !hasKeyForPrefix(r.Keys, prefix) {
+			continue
+		}
+		r.Pinned = false
+		r.Keys = nil
+		if i == len(instance.History)-1 {
+			continue
+		}
+		p, err := util.NewResolvedPath(r.Path)
+		if err != nil {
+			return ctx, fmt.Errorf("resolving path: %v", err)
+		}
+		unpin = append(unpin, p)
+	}
+	if len(unpin) == 0 {
+		return ctx, nil
+	}
+	ctx, err = dag.UnpinNodesAndBranches(ctx, b.ipfs, b.pinner, unpin, []path.Resolved{current}, instance.GetLinkEncryptionKey())
+	if err != nil {
+		return ctx, fmt.Errorf("unpinning versions: %v", err)
+	}
+	return ctx, nil
+}
+
+// hasKeyForPrefix returns whether or not keys contains a key for prefix or one of its descendants.
+func hasKeyForPrefix(keys map[string]string, prefix string) bool {
+	for p := range keys {
+		if prefix == "" || p == prefix || strings.HasPrefix(p, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// addVersion appends the bucket's current root to its history, authored by caller.
+// The replaced root is kept pinned if allowed by the number of pinned versions.
+// replacedPinned indicates whether or not the replaced root is still pinned, i.e.,
+// the update did not modify the replaced dag.
+// Roots that fall outside of the pinned versions are unpinned.
+func (b *Buckets) addVersion(
+	ctx context.Context,
+	instance *collection.Bucket,
+	caller did.DID,
+	replacedPinned bool,
+) (context.Context, error) {
+	if len(instance.History) == 0 {
+		return ctx, fmt.Errorf("bucket history is empty")
+	}
+	replaced := &instance.History[len(instance.History)-1]
+	if replaced.Path == instance.Path {
+		return ctx, nil
+	}
+
+	linkKey := instance.GetLinkEncryptionKey()
+
+	if b.pinnedVersions > 0 && !replacedPinned {
+		rp, err := util.NewResolvedPath(replaced.Path)
+		if err != nil {
+			return ctx, fmt.Errorf("resolving path: %v", err)
+		}
+		ctx, err = dag.PinNodeAndBranch(ctx, b.ipfs, b.pinner, rp, linkKey)
+		if err != nil {
+			return ctx, fmt.Errorf("pinning replaced root: %v", err)
+		}
+	} else if !replacedPinned {
+		replaced.Pinned = false
+		replaced.Keys = nil
+	}
+	instance.AddRoot(caller, instance.UpdatedAt)
+
+	// Collect roots that are no longer retained
+	current, err := util.NewResolvedPath(instance.Path)
+	if err != nil {
+		return ctx, fmt.Errorf("resolving path: %v", err)
+	}
+	keep := []path.Resolved{current}
+	kept := map[string]struct{}{instance.Path: {}}
+	var unpin []path.Resolved
+	var retained int
+	first := len(instance.History) - b.maxVersions
+	for i := len(instance.History) - 2; i >= 0; i-- {
+		r := &instance.History[i]
+		if !r.Pinned {
+			continue
+		}
+		p, err := util.NewResolvedPath(r.Path)
+		if err != nil {
+			return ctx, fmt.Errorf("resolving path: %v", err)
+		}
+		if retained < b.pinnedVersions && i >= first {
+			retained++
+			keep = append(keep, p)
+			kept[r.Path] = struct{}{}
+			continue
+		}
+		r.Pinned = false
+		r.Keys = nil
+		if _, ok := kept[r.Path]; !ok {
+			unpin = append(unpin, p)
+		}
+	}
+	if len(unpin) > 0 {
+		ctx, err = dag.UnpinNodesAndBranches(ctx, b.ipfs, b.pinner, unpin, keep, linkKey)
+		if err != nil {
+			return ctx, fmt.Errorf("unpinning versions: %v", err)
+		}
+	}
+	if first > 0 {
+		instance.History = instance.History[first:]
+	}
+	return ctx, nil
+}
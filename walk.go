@@ -0,0 +1,102 @@
+package buckets
+
+import (
+	"context"
+	"errors"
+	gopath "path"
+	"sort"
+	"strings"
+
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/textileio/go-buckets/collection"
+	"github.com/textileio/go-buckets/dag"
+)
+
+// errWalkDone stops a walk without error.
+var errWalkDone = errors.New("walk done")
+
+// walker walks bucket items in depth-first name order.
+type walker struct {
+	b        *Buckets
+	instance *collection.Bucket
+	key      []byte
+	// cursor is the path of the last item seen by a previous walk.
+	cursor string
+	// depth is the number of levels below the root to walk, or ListDepthUnlimited.
+	depth int
+	// seeds indicates whether or not bucket seed files are visited.
+	seeds bool
+}
+
+// walkFunc is called for each item visited by a walker.
+// after indicates whether or not the item comes after the walker cursor.
+// Ancestors of the cursor are visited so their descendants can be reached.
+type walkFunc func(rel string, item *PathItem, depth int, after bool) error
+
+// walk visits node and its descendants.
+// The node must already be decrypted.
+func (w *walker) walk(ctx context.Context, node ipld.Node, pth, rel string, depth int, fn walkFunc) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	item, err := w.b.nodeToItem(ctx, w.instance, node, pth, w.key, false, false)
+	if err != nil {
+		return err
+	}
+	if err := fn(rel, item, depth, comparePaths(rel, w.cursor) > 0); err != nil {
+		return err
+	}
+	if !item.IsDir || w.depth >= 0 && depth >= w.depth {
+		return nil
+	}
+
+	links := append([]*ipld.Link(nil), node.Links()...)
+	sort.Slice(links, func(i, j int) bool {
+		return links[i].Name < links[j].Name
+	})
+	for _, l := range links {
+		if l.Name == "" || !w.seeds && l.Name == collection.SeedName {
+			continue
+		}
+		crel := gopath.Join(rel, l.Name)
+		// Skip subtrees that were walked before the cursor
+		if w.cursor != "" && comparePaths(crel, w.cursor) < 0 && !strings.HasPrefix(w.cursor, crel+"/") {
+			continue
+		}
+		n, err := l.GetNode(ctx, w.b.ipfs.Dag())
+		if err != nil {
+			return err
+		}
+		if w.key != nil {
+			n, _, err = dag.DecryptNode(n, w.key)
+			if err != nil {
+				return err
+			}
+		}
+		if err := w.walk(ctx, n, gopath.Join(pth, l.Name), crel, depth+1, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// comparePaths compares slash-separated paths in depth-first walk order.
+// Parents are ordered before their children.
+func comparePaths(a, b string) int {
+	if a == b {
+		return 0
+	}
+	if b == "" {
+		return 1
+	}
+	if a == "" {
+		return -1
+	}
+	as, bs := strings.Split(a, "/"), strings.Split(b, "/")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if c := strings.Compare(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+	return len(as) - len(bs)
+}